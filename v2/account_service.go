@@ -24,6 +24,7 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/account",
+		weight:   20,
 		secType:  secTypeSigned,
 	}
 	if s.omitZeroBalances != nil {
@@ -0,0 +1,382 @@
+// Package backfill download the historical klines of the spot and futures markets into a Sink,
+// repairing the gaps and resuming from the last stored kline.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Config define how a Backfiller fetches and stores the klines
+type Config struct {
+	Source Source
+	Sink   Sink
+	// Concurrency is the number of pages fetched in parallel, 4 by default
+	Concurrency int
+	// WeightPerMinute caps the request weight used by the backfills, no cap when 0.
+	// The RateLimiter of the client still applies.
+	WeightPerMinute int64
+	// GapRetries is the number of times the missing klines of a page are fetched again, 2 by default when 0.
+	// Set it to a negative value, e.g. -1, to never fetch the missing klines again.
+	GapRetries int
+	// Now return the current time, the klines which are not closed yet are not stored. time.Now by default.
+	Now func() time.Time
+}
+
+// Job define the klines to backfill
+type Job struct {
+	Symbol   string
+	Interval string
+	// StartTime and EndTime bound the open times of the klines, EndTime defaults to now.
+	// The period before StartTime and the first kline, e.g. before the listing, is skipped.
+	StartTime time.Time
+	EndTime   time.Time
+	// Resume starts after the last kline stored by the sink, from StartTime when it has none
+	Resume bool
+}
+
+// Gap define a range of klines which could not be fetched, by their open times
+type Gap struct {
+	Start int64
+	End   int64
+}
+
+// Report describe a finished backfill
+type Report struct {
+	// Klines is the number of klines written to the sink
+	Klines   int
+	Requests int64
+	// First and Last are the open times of the first and last written klines
+	First int64
+	Last  int64
+	// Gaps are the klines still missing between the written klines after the retries, e.g. during
+	// maintenances. The klines missing after the last written kline, e.g. after a delisting, are not gaps.
+	Gaps []Gap
+}
+
+// Backfiller fetch klines from a Source into a Sink
+type Backfiller struct {
+	cfg     Config
+	limiter *common.RateLimiter
+}
+
+// New init a Backfiller
+func New(cfg Config) *Backfiller {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.GapRetries == 0 {
+		cfg.GapRetries = 2
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	b := &Backfiller{cfg: cfg}
+	if cfg.WeightPerMinute > 0 {
+		b.limiter = common.NewRateLimiter().SetPolicy(common.RateLimitPolicyWait).SetLimits(common.RateLimit{
+			RateLimitType: common.RateLimitTypeRequestWeight,
+			Interval:      common.RateLimitIntervalMinute,
+			IntervalNum:   1,
+			Limit:         cfg.WeightPerMinute,
+		})
+	}
+	return b
+}
+
+// intervalSteps are the durations of the kline intervals, except 1M which follows the calendar
+var intervalSteps = map[string]time.Duration{
+	"1s":  time.Second,
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1h":  time.Hour,
+	"2h":  2 * time.Hour,
+	"4h":  4 * time.Hour,
+	"6h":  6 * time.Hour,
+	"8h":  8 * time.Hour,
+	"12h": 12 * time.Hour,
+	"1d":  24 * time.Hour,
+	"3d":  3 * 24 * time.Hour,
+	"1w":  7 * 24 * time.Hour,
+}
+
+// intervalStep return the function giving the open time of the kline following the one opened at t
+func intervalStep(interval string) (func(t int64) int64, error) {
+	if interval == "1M" {
+		return func(t int64) int64 {
+			return time.UnixMilli(t).UTC().AddDate(0, 1, 0).UnixMilli()
+		}, nil
+	}
+	d, ok := intervalSteps[interval]
+	if !ok {
+		return nil, fmt.Errorf("backfill: unknown interval %q", interval)
+	}
+	return func(t int64) int64 {
+		return t + d.Milliseconds()
+	}, nil
+}
+
+// run is the state of a running job
+type run struct {
+	b      *Backfiller
+	job    Job
+	step   func(t int64) int64
+	now    int64
+	report *Report
+}
+
+type page struct {
+	index int
+	start int64
+	end   int64
+}
+
+type pageResult struct {
+	index  int
+	klines []*Kline
+	gaps   []Gap
+	err    error
+}
+
+// Run backfill the klines of the job. The pages are written in order, when an error is returned
+// the sink holds the klines before the failed page and the job can be resumed.
+func (b *Backfiller) Run(ctx context.Context, job Job) (*Report, error) {
+	step, err := intervalStep(job.Interval)
+	if err != nil {
+		return nil, err
+	}
+	r := &run{b: b, job: job, step: step, now: b.cfg.Now().UnixMilli(), report: &Report{}}
+	var start int64
+	if !job.StartTime.IsZero() {
+		start = job.StartTime.UnixMilli()
+	}
+	end := r.now
+	if !job.EndTime.IsZero() && job.EndTime.UnixMilli() < end {
+		end = job.EndTime.UnixMilli()
+	}
+	anchored := false
+	if job.Resume {
+		last, ok, err := b.cfg.Sink.Last(ctx, job.Symbol, job.Interval)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			start, anchored = step(last), true
+		}
+	}
+	if !anchored {
+		// the first kline sets the open times of the following ones
+		first, err := r.fetch(ctx, start, end, 1)
+		if err != nil {
+			return r.report, err
+		}
+		if len(first) == 0 {
+			return r.report, nil
+		}
+		start = first[0].OpenTime
+	}
+	if start > end {
+		return r.report, nil
+	}
+	return r.report, r.process(ctx, start, end)
+}
+
+// process fetch the pages concurrently and write them in order
+func (r *run) process(ctx context.Context, start, end int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	concurrency := r.b.cfg.Concurrency
+	pages := make(chan page)
+	results := make(chan pageResult)
+	// tokens bound the pages fetched ahead of the writer
+	tokens := make(chan struct{}, 2*concurrency)
+	// stop ends the dispatch of the pages after a failed page, the pages before it are still written
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+
+	go func() {
+		defer close(pages)
+		for i, s := 0, start; s <= end && r.step(s) <= r.now; i++ {
+			e := s
+			for n := 1; n < r.b.cfg.Source.Limit && r.step(e) <= end; n++ {
+				e = r.step(e)
+			}
+			select {
+			case tokens <- struct{}{}:
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case pages <- page{index: i, start: s, end: e}:
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+			s = r.step(e)
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range pages {
+				res := r.fetchPage(ctx, p)
+				if res.err != nil {
+					stopOnce.Do(func() { close(stop) })
+				}
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var err error
+	pending := map[int]pageResult{}
+	next := 0
+	var gaps []Gap
+	for res := range results {
+		if err != nil {
+			continue
+		}
+		pending[res.index] = res
+		for err == nil {
+			res, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			<-tokens
+			if err = res.err; err == nil {
+				err = r.write(ctx, res, &gaps)
+			}
+			if err != nil {
+				cancel()
+			}
+		}
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	return err
+}
+
+// write store the klines of a page, the gaps are reported once a kline follows them
+func (r *run) write(ctx context.Context, res pageResult, gaps *[]Gap) error {
+	if len(res.klines) > 0 {
+		if err := r.b.cfg.Sink.Write(ctx, r.job.Symbol, r.job.Interval, res.klines); err != nil {
+			return err
+		}
+	}
+	g, k := 0, 0
+	for g < len(res.gaps) || k < len(res.klines) {
+		if k == len(res.klines) || g < len(res.gaps) && res.gaps[g].Start < res.klines[k].OpenTime {
+			gap := res.gaps[g]
+			if n := len(*gaps); n > 0 && r.step((*gaps)[n-1].End) == gap.Start {
+				(*gaps)[n-1].End = gap.End
+			} else {
+				*gaps = append(*gaps, gap)
+			}
+			g++
+			continue
+		}
+		kline := res.klines[k]
+		if r.report.Klines == 0 {
+			r.report.First = kline.OpenTime
+		}
+		r.report.Klines++
+		r.report.Last = kline.OpenTime
+		r.report.Gaps = append(r.report.Gaps, *gaps...)
+		*gaps = nil
+		k++
+	}
+	return nil
+}
+
+// fetchPage fetch the klines of a page and fetch again the missing ones
+func (r *run) fetchPage(ctx context.Context, p page) pageResult {
+	res := pageResult{index: p.index}
+	klines, err := r.fetch(ctx, p.start, p.end, r.b.cfg.Source.Limit)
+	if err != nil {
+		res.err = err
+		return res
+	}
+	found := map[int64]*Kline{}
+	add := func(klines []*Kline) {
+		for _, k := range klines {
+			if k.OpenTime >= p.start && k.OpenTime <= p.end && k.CloseTime < r.now {
+				found[k.OpenTime] = k
+			}
+		}
+	}
+	add(klines)
+	for retry := 0; ; retry++ {
+		res.gaps = r.missing(p, found)
+		if len(res.gaps) == 0 || retry >= r.b.cfg.GapRetries {
+			break
+		}
+		for _, gap := range res.gaps {
+			klines, err := r.fetch(ctx, gap.Start, gap.End, r.b.cfg.Source.Limit)
+			if err != nil {
+				res.err = err
+				return res
+			}
+			add(klines)
+		}
+	}
+	res.klines = make([]*Kline, 0, len(found))
+	for _, k := range found {
+		res.klines = append(res.klines, k)
+	}
+	sort.Slice(res.klines, func(i, j int) bool {
+		return res.klines[i].OpenTime < res.klines[j].OpenTime
+	})
+	return res
+}
+
+// missing return the ranges of closed klines of the page which were not found
+func (r *run) missing(p page, found map[int64]*Kline) []Gap {
+	var gaps []Gap
+	for t := p.start; t <= p.end; t = r.step(t) {
+		if found[t] != nil || r.step(t) > r.now {
+			continue
+		}
+		if n := len(gaps); n > 0 && r.step(gaps[n-1].End) == t {
+			gaps[n-1].End = t
+		} else {
+			gaps = append(gaps, Gap{Start: t, End: t})
+		}
+	}
+	return gaps
+}
+
+// fetch send a request within the weight budget
+func (r *run) fetch(ctx context.Context, start, end int64, limit int) ([]*Kline, error) {
+	src := r.b.cfg.Source
+	if r.b.limiter != nil {
+		if err := r.b.limiter.Reserve(ctx, src.Weight(limit), false); err != nil {
+			return nil, err
+		}
+	}
+	atomic.AddInt64(&r.report.Requests, 1)
+	return src.Fetch(ctx, r.job.Symbol, r.job.Interval, start, end, limit)
+}
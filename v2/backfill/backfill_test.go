@@ -0,0 +1,190 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var listing = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeExchange serve 1m klines from the listing, without the klines in missing
+type fakeExchange struct {
+	mu       sync.Mutex
+	count    int
+	missing  map[int]bool
+	flaky    map[int]bool
+	failFrom int64
+	requests int
+}
+
+func minute(i int) int64 {
+	return listing.Add(time.Duration(i) * time.Minute).UnixMilli()
+}
+
+func (e *fakeExchange) source(limit int) Source {
+	return Source{
+		Limit:  limit,
+		Weight: func(limit int) int64 { return 1 },
+		Fetch: func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error) {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.requests++
+			if e.failFrom != 0 && start >= e.failFrom {
+				return nil, errors.New("failed")
+			}
+			var res []*Kline
+			for i := 0; i < e.count && len(res) < limit; i++ {
+				t := minute(i)
+				if t < start || t > end || e.missing[i] {
+					continue
+				}
+				if e.flaky[i] {
+					// missing from the first response only
+					delete(e.flaky, i)
+					continue
+				}
+				res = append(res, &Kline{OpenTime: t, CloseTime: minute(i+1) - 1, Close: "1"})
+			}
+			return res, nil
+		},
+	}
+}
+
+func openTimes(klines []*Kline) []int64 {
+	res := make([]int64, len(klines))
+	for i, k := range klines {
+		res[i] = k.OpenTime
+	}
+	return res
+}
+
+func TestBackfill(t *testing.T) {
+	exchange := &fakeExchange{
+		count:   2500,
+		missing: map[int]bool{1200: true, 1201: true, 1202: true},
+		flaky:   map[int]bool{300: true, 301: true, 1999: true},
+	}
+	sink := NewMemorySink()
+	b := New(Config{
+		Source:      exchange.source(100),
+		Sink:        sink,
+		Concurrency: 3,
+		// the kline of the minute 2000 is not closed yet
+		Now: func() time.Time { return listing.Add(2000*time.Minute + 30*time.Second) },
+	})
+	report, err := b.Run(context.Background(), Job{
+		Symbol:    "BTCUSDT",
+		Interval:  "1m",
+		StartTime: listing.Add(-24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1997, report.Klines)
+	assert.Equal(t, minute(0), report.First)
+	assert.Equal(t, minute(1999), report.Last)
+	assert.Equal(t, []Gap{{Start: minute(1200), End: minute(1202)}}, report.Gaps)
+	// the first kline, 20 pages, 2 retries of the flaky klines and 2 retries of the gap
+	assert.Equal(t, int64(25), report.Requests)
+
+	klines := sink.Klines("BTCUSDT", "1m")
+	require.Len(t, klines, 1997)
+	times := openTimes(klines)
+	for i := 1; i < len(times); i++ {
+		assert.Less(t, times[i-1], times[i])
+	}
+	assert.Contains(t, times, minute(300))
+	assert.NotContains(t, times, minute(1200))
+
+	// resume after the last stored kline, the missing klines after the delisting are not gaps
+	b.cfg.Now = func() time.Time { return listing.Add(3000 * time.Minute) }
+	report, err = b.Run(context.Background(), Job{Symbol: "BTCUSDT", Interval: "1m", Resume: true})
+	require.NoError(t, err)
+	assert.Equal(t, 500, report.Klines)
+	assert.Equal(t, minute(2000), report.First)
+	assert.Equal(t, minute(2499), report.Last)
+	assert.Empty(t, report.Gaps)
+	assert.Len(t, sink.Klines("BTCUSDT", "1m"), 2497)
+}
+
+func TestBackfillNoGapRetries(t *testing.T) {
+	exchange := &fakeExchange{count: 200, flaky: map[int]bool{150: true}}
+	sink := NewMemorySink()
+	b := New(Config{
+		Source:     exchange.source(100),
+		Sink:       sink,
+		GapRetries: -1,
+		Now:        func() time.Time { return listing.Add(200 * time.Minute) },
+	})
+	report, err := b.Run(context.Background(), Job{Symbol: "BTCUSDT", Interval: "1m", StartTime: listing})
+	require.NoError(t, err)
+	// the first kline and 2 pages, the flaky kline is not fetched again
+	assert.Equal(t, int64(3), report.Requests)
+	assert.Equal(t, []Gap{{Start: minute(150), End: minute(150)}}, report.Gaps)
+	assert.Equal(t, 199, report.Klines)
+}
+
+func TestBackfillError(t *testing.T) {
+	exchange := &fakeExchange{count: 1000, failFrom: minute(500)}
+	sink := NewMemorySink()
+	b := New(Config{
+		Source:          exchange.source(100),
+		Sink:            sink,
+		WeightPerMinute: 100,
+		Now:             func() time.Time { return listing.Add(24 * time.Hour) },
+	})
+	_, err := b.Run(context.Background(), Job{Symbol: "BTCUSDT", Interval: "1m", StartTime: listing})
+	assert.EqualError(t, err, "failed")
+	// the pages before the failure are stored
+	klines := sink.Klines("BTCUSDT", "1m")
+	require.Len(t, klines, 500)
+	assert.Equal(t, minute(499), klines[499].OpenTime)
+
+	_, err = b.Run(context.Background(), Job{Symbol: "BTCUSDT", Interval: "2x"})
+	assert.EqualError(t, err, `backfill: unknown interval "2x"`)
+}
+
+func TestIntervalStep(t *testing.T) {
+	step, err := intervalStep("1M")
+	require.NoError(t, err)
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).UnixMilli(), step(jan.UnixMilli()))
+	step, err = intervalStep("1w")
+	require.NoError(t, err)
+	assert.Equal(t, jan.AddDate(0, 0, 7).UnixMilli(), step(jan.UnixMilli()))
+}
+
+func TestFuturesKline(t *testing.T) {
+	k := &futures.Kline{
+		OpenTime:                 1,
+		Open:                     "2",
+		High:                     "3",
+		Low:                      "4",
+		Close:                    "5",
+		Volume:                   "6",
+		CloseTime:                7,
+		QuoteAssetVolume:         "8",
+		TradeNum:                 9,
+		TakerBuyBaseAssetVolume:  "10",
+		TakerBuyQuoteAssetVolume: "11",
+	}
+	expected := &Kline{
+		OpenTime:                 1,
+		Open:                     "2",
+		High:                     "3",
+		Low:                      "4",
+		Close:                    "5",
+		Volume:                   "6",
+		CloseTime:                7,
+		QuoteAssetVolume:         "8",
+		TradeNum:                 9,
+		TakerBuyBaseAssetVolume:  "10",
+		TakerBuyQuoteAssetVolume: "11",
+	}
+	assert.Equal(t, expected, futuresKline(k))
+}
@@ -0,0 +1,57 @@
+package backfill
+
+import (
+	"context"
+	"sync"
+)
+
+// Sink store the klines of the backfills. Write is called by a single goroutine per job,
+// with consecutive pages in open time order.
+type Sink interface {
+	Write(ctx context.Context, symbol, interval string, klines []*Kline) error
+	// Last return the open time of the last stored kline, ok is false when there is none
+	Last(ctx context.Context, symbol, interval string) (openTime int64, ok bool, err error)
+}
+
+type sinkKey struct {
+	symbol   string
+	interval string
+}
+
+// MemorySink keep the klines in memory
+type MemorySink struct {
+	mu     sync.Mutex
+	klines map[sinkKey][]*Kline
+}
+
+// NewMemorySink init an empty MemorySink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{klines: map[sinkKey][]*Kline{}}
+}
+
+// Write implement Sink
+func (s *MemorySink) Write(ctx context.Context, symbol, interval string, klines []*Kline) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := sinkKey{symbol, interval}
+	s.klines[key] = append(s.klines[key], klines...)
+	return nil
+}
+
+// Last implement Sink
+func (s *MemorySink) Last(ctx context.Context, symbol, interval string) (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	klines := s.klines[sinkKey{symbol, interval}]
+	if len(klines) == 0 {
+		return 0, false, nil
+	}
+	return klines[len(klines)-1].OpenTime, true, nil
+}
+
+// Klines return the stored klines of symbol and interval
+func (s *MemorySink) Klines(symbol, interval string) []*Kline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Kline(nil), s.klines[sinkKey{symbol, interval}]...)
+}
@@ -0,0 +1,219 @@
+package backfill
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Kline define a kline fetched by a backfill, whatever its market
+type Kline struct {
+	OpenTime                 int64  `json:"openTime"`
+	Open                     string `json:"open"`
+	High                     string `json:"high"`
+	Low                      string `json:"low"`
+	Close                    string `json:"close"`
+	Volume                   string `json:"volume"`
+	CloseTime                int64  `json:"closeTime"`
+	QuoteAssetVolume         string `json:"quoteAssetVolume"`
+	TradeNum                 int64  `json:"tradeNum"`
+	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
+	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
+}
+
+// Source define a klines endpoint
+type Source struct {
+	// Limit is the number of klines requested per page
+	Limit int
+	// Weight return the request weight of a page of limit klines
+	Weight func(limit int) int64
+	// Fetch return at most limit klines of symbol opened between start and end included, oldest first
+	Fetch func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error)
+}
+
+// futuresWeight is the weight of the klines endpoints of the futures, depending on the limit
+func futuresWeight(limit int) int64 {
+	switch {
+	case limit < 100:
+		return 1
+	case limit < 500:
+		return 2
+	case limit <= 1000:
+		return 5
+	}
+	return 10
+}
+
+// futuresLimit is the page size fetching the most klines per weight
+const futuresLimit = 499
+
+// Spot return the source of the spot klines
+func Spot(c *binance.Client) Source {
+	return Source{
+		Limit:  1000,
+		Weight: func(limit int) int64 { return 2 },
+		Fetch: func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error) {
+			klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(start).EndTime(end).Limit(limit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]*Kline, len(klines))
+			for i, k := range klines {
+				res[i] = spotKline(k)
+			}
+			return res, nil
+		},
+	}
+}
+
+// Futures return the source of the USD-M futures klines
+func Futures(c *futures.Client) Source {
+	return Source{
+		Limit:  futuresLimit,
+		Weight: futuresWeight,
+		Fetch: func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error) {
+			klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(start).EndTime(end).Limit(limit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]*Kline, len(klines))
+			for i, k := range klines {
+				res[i] = futuresKline(k)
+			}
+			return res, nil
+		},
+	}
+}
+
+// FuturesContinuous return the source of the USD-M continuous contract klines of a contract type,
+// the symbol of the jobs is the pair
+func FuturesContinuous(c *futures.Client, contractType string) Source {
+	return Source{
+		Limit:  futuresLimit,
+		Weight: futuresWeight,
+		Fetch: func(ctx context.Context, pair, interval string, start, end int64, limit int) ([]*Kline, error) {
+			klines, err := c.NewContinuousKlinesService().Pair(pair).ContractType(contractType).Interval(interval).
+				StartTime(start).EndTime(end).Limit(limit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]*Kline, len(klines))
+			for i, k := range klines {
+				res[i] = continuousKline(k)
+			}
+			return res, nil
+		},
+	}
+}
+
+// FuturesMarkPrice return the source of the USD-M mark price klines, they have no volumes
+func FuturesMarkPrice(c *futures.Client) Source {
+	return Source{
+		Limit:  futuresLimit,
+		Weight: futuresWeight,
+		Fetch: func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error) {
+			klines, err := c.NewMarkPriceKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(start).EndTime(end).Limit(limit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]*Kline, len(klines))
+			for i, k := range klines {
+				res[i] = futuresKline(k)
+			}
+			return res, nil
+		},
+	}
+}
+
+// Delivery return the source of the COIN-M futures klines
+func Delivery(c *delivery.Client) Source {
+	return Source{
+		Limit:  futuresLimit,
+		Weight: futuresWeight,
+		Fetch: func(ctx context.Context, symbol, interval string, start, end int64, limit int) ([]*Kline, error) {
+			klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(start).EndTime(end).Limit(limit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]*Kline, len(klines))
+			for i, k := range klines {
+				res[i] = deliveryKline(k)
+			}
+			return res, nil
+		},
+	}
+}
+
+// spotKline convert a spot kline
+func spotKline(k *binance.Kline) *Kline {
+	return &Kline{
+		OpenTime:                 k.OpenTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.CloseTime,
+		QuoteAssetVolume:         k.QuoteAssetVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.TakerBuyBaseAssetVolume,
+		TakerBuyQuoteAssetVolume: k.TakerBuyQuoteAssetVolume,
+	}
+}
+
+// futuresKline convert a USD-M futures kline
+func futuresKline(k *futures.Kline) *Kline {
+	return &Kline{
+		OpenTime:                 k.OpenTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.CloseTime,
+		QuoteAssetVolume:         k.QuoteAssetVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.TakerBuyBaseAssetVolume,
+		TakerBuyQuoteAssetVolume: k.TakerBuyQuoteAssetVolume,
+	}
+}
+
+// continuousKline convert a USD-M continuous contract kline
+func continuousKline(k *futures.ContinuousKline) *Kline {
+	return &Kline{
+		OpenTime:                 k.OpenTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.CloseTime,
+		QuoteAssetVolume:         k.QuoteAssetVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.TakerBuyBaseAssetVolume,
+		TakerBuyQuoteAssetVolume: k.TakerBuyQuoteAssetVolume,
+	}
+}
+
+// deliveryKline convert a COIN-M futures kline
+func deliveryKline(k *delivery.Kline) *Kline {
+	return &Kline{
+		OpenTime:                 k.OpenTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.CloseTime,
+		QuoteAssetVolume:         k.QuoteAssetVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.TakerBuyBaseAssetVolume,
+		TakerBuyQuoteAssetVolume: k.TakerBuyQuoteAssetVolume,
+	}
+}
@@ -0,0 +1,113 @@
+package binancetest
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// amountDecimals is the number of decimals of prices, quantities and balances, as used by Binance
+const amountDecimals = 8
+
+var amountScale = big.NewInt(100000000)
+
+// amount is a fixed point number with 8 decimals
+type amount int64
+
+// parseAmount parse a decimal string, it fails when more than 8 decimals are significant
+func parseAmount(s string) (amount, error) {
+	if s == "" {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	fracPart = strings.TrimRight(fracPart, "0")
+	if (intPart == "" && fracPart == "") || len(fracPart) > amountDecimals {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	var v int64
+	for _, c := range intPart + fracPart + strings.Repeat("0", amountDecimals-len(fracPart)) {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid number %q", s)
+		}
+		v = v*10 + int64(c-'0')
+		if v < 0 {
+			return 0, fmt.Errorf("number out of range %q", s)
+		}
+	}
+	if neg {
+		v = -v
+	}
+	return amount(v), nil
+}
+
+// intAmount return the amount of an integer
+func intAmount(i int64) amount {
+	return amount(i * 100000000)
+}
+
+// mustAmount parse s and panic on invalid numbers, for configuration values
+func mustAmount(s string) amount {
+	if s == "" {
+		return 0
+	}
+	a, err := parseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String format the amount with 8 decimals like "0.00100000"
+func (a amount) String() string {
+	sign := ""
+	v := int64(a)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%08d", sign, v/100000000, v%100000000)
+}
+
+// mul return a*b truncated to 8 decimals
+func (a amount) mul(b amount) amount {
+	v := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	return amount(v.Quo(v, amountScale).Int64())
+}
+
+// div return a/b truncated to 8 decimals
+func (a amount) div(b amount) amount {
+	if b == 0 {
+		return 0
+	}
+	v := new(big.Int).Mul(big.NewInt(int64(a)), amountScale)
+	return amount(v.Quo(v, big.NewInt(int64(b))).Int64())
+}
+
+// floor round a down to a multiple of step
+func (a amount) floor(step amount) amount {
+	if step <= 0 {
+		return a
+	}
+	return a - a%step
+}
+
+func minAmount(a, b amount) amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (a amount) abs() amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,143 @@
+package binancetest
+
+import (
+	"sort"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// order is an order resting on or matched against a book
+type order struct {
+	id            int64
+	clientOrderID string
+	symbol        string
+	side          binance.SideType
+	orderType     binance.OrderType
+	timeInForce   binance.TimeInForceType
+	price         amount
+	origQty       amount
+	origQuoteQty  amount
+	executedQty   amount
+	cumQuote      amount
+	status        binance.OrderStatusType
+	time          int64
+	updateTime    int64
+	// locked is the balance still locked by the order, quote asset for buy orders and base asset for sell orders
+	locked amount
+	// liquidity orders are added by the tests and do not belong to the account
+	liquidity bool
+	// positionSide and reduceOnly are only set on futures orders
+	positionSide futures.PositionSideType
+	reduceOnly   bool
+}
+
+func (o *order) remaining() amount {
+	return o.origQty - o.executedQty
+}
+
+func (o *order) isOpen() bool {
+	return o.status == binance.OrderStatusTypeNew || o.status == binance.OrderStatusTypePartiallyFilled
+}
+
+// fill is a match between a taker order and a resting order at the price of the resting order
+type fill struct {
+	maker *order
+	price amount
+	qty   amount
+}
+
+// book keeps the resting orders of a symbol by price-time priority
+type book struct {
+	bids []*order // highest price first
+	asks []*order // lowest price first
+}
+
+// better report whether price a has priority over price b on the side
+func better(side binance.SideType, a, b amount) bool {
+	if side == binance.SideTypeBuy {
+		return a > b
+	}
+	return a < b
+}
+
+func (b *book) orders(side binance.SideType) *[]*order {
+	if side == binance.SideTypeBuy {
+		return &b.bids
+	}
+	return &b.asks
+}
+
+// add rest o after the orders of the same or a better price
+func (b *book) add(o *order) {
+	orders := b.orders(o.side)
+	i := sort.Search(len(*orders), func(i int) bool {
+		return better(o.side, o.price, (*orders)[i].price)
+	})
+	*orders = append(*orders, nil)
+	copy((*orders)[i+1:], (*orders)[i:])
+	(*orders)[i] = o
+}
+
+func (b *book) remove(o *order) {
+	orders := b.orders(o.side)
+	for i, r := range *orders {
+		if r == o {
+			*orders = append((*orders)[:i], (*orders)[i+1:]...)
+			return
+		}
+	}
+}
+
+// match return the fills of a taker order without executing them. limit is the limit price, 0 for market orders.
+// The fills are bounded by qty, or by the quote amount quoteQty when qty is 0, quantities are multiples of step.
+func (b *book) match(side binance.SideType, limit, qty, quoteQty, step amount) []fill {
+	opposite := binance.SideTypeSell
+	if side == binance.SideTypeSell {
+		opposite = binance.SideTypeBuy
+	}
+	var fills []fill
+	for _, maker := range *b.orders(opposite) {
+		if limit > 0 && better(opposite, limit, maker.price) {
+			break
+		}
+		q := maker.remaining()
+		if qty > 0 {
+			q = minAmount(q, qty)
+			qty -= q
+		} else {
+			q = minAmount(q, quoteQty.div(maker.price).floor(step))
+			quoteQty -= maker.price.mul(q)
+		}
+		if q <= 0 {
+			break
+		}
+		fills = append(fills, fill{maker: maker, price: maker.price, qty: q})
+		if qty == 0 && quoteQty <= 0 {
+			break
+		}
+	}
+	return fills
+}
+
+// priceLevel is an aggregated level of a book
+type priceLevel struct {
+	price amount
+	qty   amount
+}
+
+// levels return up to limit aggregated price levels of the side
+func (b *book) levels(side binance.SideType, limit int) []priceLevel {
+	var levels []priceLevel
+	for _, o := range *b.orders(side) {
+		if n := len(levels); n > 0 && levels[n-1].price == o.price {
+			levels[n-1].qty += o.remaining()
+			continue
+		}
+		if len(levels) == limit {
+			break
+		}
+		levels = append(levels, priceLevel{price: o.price, qty: o.remaining()})
+	}
+	return levels
+}
@@ -0,0 +1,343 @@
+package binancetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CassetteMode define whether a cassette records or replays the interactions
+type CassetteMode int
+
+// Cassette modes
+const (
+	// CassetteReplay serve the recorded interactions, requests and streams which were not recorded fail
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord send the requests, open the streams and record them, see Cassette.Save
+	CassetteRecord
+)
+
+// scrubbedParams are never written to a cassette nor used to match the requests
+var scrubbedParams = []string{"signature", "timestamp", "recvWindow", "apiKey"}
+
+// scrubbedHeaders are never written to a cassette
+var scrubbedHeaders = []string{"X-Mbx-Apikey", "Set-Cookie", "Date"}
+
+// Cassette record the REST requests and websocket streams of the clients into a file and replay them,
+// making tests against the real API deterministic and offline. Install it on any client with
+//
+//	client.HTTPClient = cassette.HTTPClient(client.HTTPClient)
+//	client.Environment.WsMiddlewares = append(client.Environment.WsMiddlewares, cassette.WsMiddleware())
+//
+// The API key, signatures, timestamps and receive windows are not recorded. Requests are matched
+// on their method, endpoint and the remaining parameters, whatever the host and the order of the
+// parameters; streams are matched on their endpoint. Identical requests are replayed in the recorded
+// order, the last one is served again once they were all used, e.g. for polled endpoints.
+type Cassette struct {
+	// IgnoredParams are not used to match the requests, they default to newClientOrderId which is
+	// generated by the clients
+	IgnoredParams []string
+
+	path string
+	mode CassetteMode
+	mu   sync.Mutex
+	data cassetteData
+	// used count the replays of every interaction and stream
+	used map[interface{}]int
+}
+
+type cassetteData struct {
+	Interactions []*Interaction `json:"interactions"`
+	Streams      []*Stream      `json:"streams"`
+}
+
+// Interaction define a recorded REST request and its response
+type Interaction struct {
+	Method   string      `json:"method"`
+	Endpoint string      `json:"endpoint"`
+	Params   url.Values  `json:"params,omitempty"`
+	Status   int         `json:"status"`
+	Header   http.Header `json:"header,omitempty"`
+	Body     string      `json:"body"`
+}
+
+// Stream define a recorded websocket stream and its messages
+type Stream struct {
+	Endpoint string   `json:"endpoint"`
+	Messages []string `json:"messages"`
+}
+
+// NewCassette create a cassette stored at path, the recorded interactions are loaded in replay mode
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{
+		IgnoredParams: []string{"newClientOrderId"},
+		path:          path,
+		mode:          mode,
+		used:          map[interface{}]int{},
+	}
+	if mode == CassetteReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(b, &c.data); err != nil {
+			return nil, fmt.Errorf("binancetest: invalid cassette %s: %w", path, err)
+		}
+	}
+	return c, nil
+}
+
+// Mode return the mode of the cassette
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Interactions return the recorded REST interactions
+func (c *Cassette) Interactions() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Interaction(nil), c.data.Interactions...)
+}
+
+// Streams return the recorded websocket streams, the messages of open streams are still being recorded
+func (c *Cassette) Streams() []*Stream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	streams := make([]*Stream, len(c.data.Streams))
+	for i, s := range c.data.Streams {
+		streams[i] = &Stream{Endpoint: s.Endpoint, Messages: append([]string(nil), s.Messages...)}
+	}
+	return streams
+}
+
+// Save write the recorded interactions and streams to the cassette file
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	b, err := json.MarshalIndent(&c.data, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(b, '\n'), 0o644)
+}
+
+// HTTPClient return a copy of client sending its requests through the cassette,
+// a default client is used when client is nil
+func (c *Cassette) HTTPClient(client *http.Client) *http.Client {
+	hc := &http.Client{}
+	if client != nil {
+		*hc = *client
+	}
+	next := hc.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	hc.Transport = &cassetteTransport{cassette: c, next: next}
+	return hc
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+// RoundTrip implement http.RoundTripper
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	c := t.cassette
+	if c.mode == CassetteReplay {
+		return c.replay(req, params)
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	header := res.Header.Clone()
+	for _, h := range scrubbedHeaders {
+		header.Del(h)
+	}
+	c.mu.Lock()
+	c.data.Interactions = append(c.data.Interactions, &Interaction{
+		Method:   req.Method,
+		Endpoint: req.URL.Path,
+		Params:   params,
+		Status:   res.StatusCode,
+		Header:   header,
+		Body:     string(body),
+	})
+	c.mu.Unlock()
+	return res, nil
+}
+
+// replay serve the matching recorded interaction
+func (c *Cassette) replay(req *http.Request, params url.Values) (*http.Response, error) {
+	key := c.matchKey(params)
+	c.mu.Lock()
+	var matches []*Interaction
+	for _, i := range c.data.Interactions {
+		if i.Method == req.Method && i.Endpoint == req.URL.Path && c.matchKey(i.Params) == key {
+			matches = append(matches, i)
+		}
+	}
+	var found *Interaction
+	for _, i := range matches {
+		if c.used[i] == 0 {
+			found = i
+			break
+		}
+	}
+	if found == nil && len(matches) > 0 {
+		found = matches[len(matches)-1]
+	}
+	if found != nil {
+		c.used[found]++
+	}
+	c.mu.Unlock()
+	if found == nil {
+		return nil, fmt.Errorf("binancetest: no recorded interaction for %s %s?%s", req.Method, req.URL.Path, key)
+	}
+	header := found.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", found.Status, http.StatusText(found.Status)),
+		StatusCode:    found.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(found.Body)),
+		ContentLength: int64(len(found.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matchKey return the encoded parameters used to match the requests
+func (c *Cassette) matchKey(params url.Values) string {
+	v := url.Values{}
+	for k, values := range params {
+		v[k] = values
+	}
+	for _, p := range c.IgnoredParams {
+		v.Del(p)
+	}
+	return v.Encode()
+}
+
+// requestParams return the scrubbed query and form parameters of req, the body is left readable
+func requestParams(req *http.Request) (url.Values, error) {
+	params := req.URL.Query()
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, values := range form {
+			params[k] = append(params[k], values...)
+		}
+	}
+	for _, p := range scrubbedParams {
+		params.Del(p)
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+	return params, nil
+}
+
+// WsMiddleware return the middleware recording or replaying the websocket streams,
+// see Environment.WsMiddlewares
+func (c *Cassette) WsMiddleware() common.WsMiddleware {
+	return func(next common.WsServeFunc) common.WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			key, err := streamKey(endpoint)
+			if err != nil {
+				return nil, nil, err
+			}
+			if c.mode == CassetteReplay {
+				return c.replayStream(key, handler)
+			}
+			stream := &Stream{Endpoint: key, Messages: []string{}}
+			c.mu.Lock()
+			c.data.Streams = append(c.data.Streams, stream)
+			c.mu.Unlock()
+			return next(endpoint, func(message []byte) {
+				c.mu.Lock()
+				stream.Messages = append(stream.Messages, string(message))
+				c.mu.Unlock()
+				handler(message)
+			}, errHandler)
+		}
+	}
+}
+
+// replayStream send the messages of the matching recorded stream to handler, the stream then stays
+// open without messages until stopC is closed
+func (c *Cassette) replayStream(key string, handler func(message []byte)) (chan struct{}, chan struct{}, error) {
+	c.mu.Lock()
+	var found *Stream
+	for _, s := range c.data.Streams {
+		if s.Endpoint == key && c.used[s] == 0 {
+			found = s
+			break
+		}
+	}
+	if found != nil {
+		c.used[found]++
+	}
+	c.mu.Unlock()
+	if found == nil {
+		return nil, nil, fmt.Errorf("binancetest: no recorded stream for %s", key)
+	}
+	doneC := make(chan struct{})
+	stopC := make(chan struct{})
+	go func() {
+		defer close(doneC)
+		for _, m := range found.Messages {
+			select {
+			case <-stopC:
+				return
+			default:
+			}
+			handler([]byte(m))
+		}
+		<-stopC
+	}()
+	return doneC, stopC, nil
+}
+
+// streamKey return the path and the sorted query of endpoint, the host is ignored
+func streamKey(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if u.RawQuery == "" {
+		return u.Path, nil
+	}
+	return u.Path + "?" + u.Query().Encode(), nil
+}
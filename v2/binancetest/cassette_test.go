@@ -0,0 +1,127 @@
+package binancetest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type cassetteResults struct {
+	order   *binance.CreateOrderResponse
+	account *binance.Account
+	err     error
+	events  []*binance.WsUserDataEvent
+}
+
+// runCassetteScenario place an order and read the account and the user data stream with client
+func runCassetteScenario(t *testing.T, client *binance.Client) cassetteResults {
+	ctx := context.Background()
+	listenKey, err := client.NewStartUserStreamService().Do(ctx)
+	require.NoError(t, err)
+	events := make(chan *binance.WsUserDataEvent, 10)
+	_, stopC, err := client.Environment.WsUserDataServe(listenKey, func(event *binance.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	require.NoError(t, err)
+	defer close(stopC)
+
+	var r cassetteResults
+	r.order, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.01").Do(ctx)
+	require.NoError(t, err)
+	for len(r.events) < 2 {
+		select {
+		case e := <-events:
+			r.events = append(r.events, e)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no user data event")
+		}
+	}
+	r.account, err = client.NewGetAccountService().Do(ctx)
+	require.NoError(t, err)
+	_, r.err = client.NewCreateOrderService().Symbol("ETHUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.01").Do(ctx)
+	return r
+}
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := NewServer(Config{
+		APIKey:    "cassetteAPIKey",
+		SecretKey: "cassetteSecretKey",
+		Balances:  map[string]string{"USDT": "10000"},
+	})
+	_, err := server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "30000", "0.01")
+	require.NoError(t, err)
+
+	recorder, err := NewCassette(path, CassetteRecord)
+	require.NoError(t, err)
+	client := server.NewClient()
+	client.HTTPClient = recorder.HTTPClient(client.HTTPClient)
+	client.Environment.WsMiddlewares = []common.WsMiddleware{recorder.WsMiddleware()}
+	recorded := runCassetteScenario(t, client)
+	server.Close()
+	require.ErrorIs(t, recorded.err, common.ErrBadSymbol)
+	require.NoError(t, recorder.Save())
+	require.Len(t, recorder.Streams(), 1)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"cassetteAPIKey", "signature", "timestamp", "recvWindow"} {
+		assert.NotContains(t, string(b), secret)
+	}
+
+	// the replay does not need the server nor the credentials
+	player, err := NewCassette(path, CassetteReplay)
+	require.NoError(t, err)
+	client = binance.NewClient("otherAPIKey", "otherSecretKey", server.Environment())
+	client.HTTPClient = player.HTTPClient(nil)
+	client.Environment.WsMiddlewares = []common.WsMiddleware{player.WsMiddleware()}
+	replayed := runCassetteScenario(t, client)
+	assert.Equal(t, recorded.order, replayed.order)
+	assert.Equal(t, recorded.account, replayed.account)
+	assert.Equal(t, recorded.events, replayed.events)
+	assert.ErrorIs(t, replayed.err, common.ErrBadSymbol)
+
+	_, err = client.NewDepthService().Symbol("BTCUSDT").Do(context.Background())
+	assert.ErrorContains(t, err, "no recorded interaction for GET /api/v3/depth?symbol=BTCUSDT")
+	_, _, err = client.Environment.WsDepthServe("BTCUSDT", func(event *binance.WsDepthEvent) {}, func(err error) {})
+	assert.ErrorContains(t, err, "no recorded stream for /ws/btcusdt@depth")
+}
+
+func TestCassetteMatching(t *testing.T) {
+	c := &Cassette{IgnoredParams: []string{"newClientOrderId"}, mode: CassetteReplay, used: map[interface{}]int{}}
+	c.data.Interactions = []*Interaction{
+		{Method: "GET", Endpoint: "/api/v3/order", Params: map[string][]string{"symbol": {"BTCUSDT"}, "orderId": {"1"}}, Status: 200, Body: "first"},
+		{Method: "GET", Endpoint: "/api/v3/order", Params: map[string][]string{"symbol": {"BTCUSDT"}, "orderId": {"1"}}, Status: 200, Body: "second"},
+		{Method: "POST", Endpoint: "/api/v3/order", Params: map[string][]string{"symbol": {"BTCUSDT"}}, Status: 400, Body: "rejected"},
+	}
+	hc := c.HTTPClient(nil)
+	get := func(rawURL string) string {
+		res, err := hc.Get(rawURL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		b := make([]byte, 100)
+		n, _ := res.Body.Read(b)
+		return string(b[:n])
+	}
+	// the host, the order of the parameters and the scrubbed parameters are ignored
+	assert.Equal(t, "first", get("https://api.binance.com/api/v3/order?orderId=1&symbol=BTCUSDT&timestamp=1&signature=abc"))
+	assert.Equal(t, "second", get("http://localhost/api/v3/order?symbol=BTCUSDT&orderId=1&timestamp=2&signature=def"))
+	assert.Equal(t, "second", get("http://localhost/api/v3/order?symbol=BTCUSDT&orderId=1"))
+	_, err := hc.Get("http://localhost/api/v3/order?symbol=BTCUSDT&orderId=2")
+	assert.Error(t, err)
+
+	res, err := hc.PostForm("http://localhost/api/v3/order", map[string][]string{"symbol": {"BTCUSDT"}, "newClientOrderId": {"x"}})
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, 400, res.StatusCode)
+}
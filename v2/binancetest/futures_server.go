@@ -0,0 +1,1612 @@
+package binancetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// FuturesConfig define the account and the market of a fake USD-M futures exchange
+type FuturesConfig struct {
+	// APIKey is the expected X-MBX-APIKEY header, any key is accepted when empty
+	APIKey string
+	// SecretKey is the HMAC secret signed requests are verified with, signatures are not verified when empty
+	SecretKey string
+	// Symbols are the perpetual contracts, BTCUSDT is traded when empty.
+	// The quote asset of a symbol is its margin asset.
+	Symbols []SymbolConfig
+	// Balances are the initial wallet balances of the account by margin asset
+	Balances map[string]string
+	// MakerCommission and TakerCommission are the commission rates, e.g. "0.0002",
+	// commissions are paid with the margin asset
+	MakerCommission string
+	TakerCommission string
+	// Leverage is the initial leverage of the symbols, 20 when 0
+	Leverage int
+	// MaintMarginRate is the maintenance margin rate of the positions, "0.004" when empty
+	MaintMarginRate string
+	// FundingRate is the initial funding rate of the symbols, "0.0001" when empty
+	FundingRate string
+	// FundingInterval is the time between two fundings, funding times are aligned on the Unix epoch.
+	// It is 8 hours when 0. Funding is settled when the exchange is used after a funding time.
+	FundingInterval time.Duration
+	// Now is the clock of the exchange, time.Now when nil
+	Now func() time.Time
+}
+
+// marginCallRatio is the ratio of maintenance margin to margin balance above which MARGIN_CALL is pushed
+var marginCallRatio = mustAmount("0.8")
+
+// contract is a symbol of the futures exchange and the account settings of it
+type contract struct {
+	*symbol
+	leverage    int64
+	marginType  futures.MarginType
+	markPrice   amount
+	markSet     bool
+	fundingRate amount
+}
+
+type positionKey struct {
+	symbol string
+	side   futures.PositionSideType
+}
+
+// position is a position of the account, amt is negative for short positions
+type position struct {
+	amt        amount
+	entryPrice amount
+	// isolatedWallet is the margin of an isolated position
+	isolatedWallet amount
+	realized       amount
+	updateTime     int64
+}
+
+// FuturesServer is a fake USD-M futures exchange serving a single account.
+// It keeps positions in one-way or hedge mode with cross or isolated margin,
+// computes the unrealized PnL from the mark price and settles funding.
+// Positions are not liquidated.
+type FuturesServer struct {
+	// URL is the base URL of the REST API
+	URL string
+	// WsURL is the base URL of the websocket streams
+	WsURL string
+
+	cfg     FuturesConfig
+	server  *httptest.Server
+	api     *api
+	streams *userStreams
+
+	mu              sync.Mutex
+	contracts       map[string]*contract
+	wallets         map[string]amount // wallet balances by asset, isolated margins included
+	positions       map[positionKey]*position
+	dualSide        bool
+	makerCommission amount
+	takerCommission amount
+	maintMarginRate amount
+	fundingInterval int64
+	nextFundingTime int64
+	orders          []*order // orders of the account by id
+	trades          []*futures.AccountTrade
+	incomes         []*futures.IncomeHistory
+	nextOrderID     int64
+	nextTradeID     int64
+	nextTranID      int64
+	updateID        int64
+}
+
+// NewFuturesServer start a fake USD-M futures exchange, it panics on invalid numbers in cfg
+func NewFuturesServer(cfg FuturesConfig) *FuturesServer {
+	if len(cfg.Symbols) == 0 {
+		cfg.Symbols = []SymbolConfig{DefaultSymbol}
+	}
+	if cfg.Leverage == 0 {
+		cfg.Leverage = 20
+	}
+	if cfg.MaintMarginRate == "" {
+		cfg.MaintMarginRate = "0.004"
+	}
+	if cfg.FundingRate == "" {
+		cfg.FundingRate = "0.0001"
+	}
+	if cfg.FundingInterval == 0 {
+		cfg.FundingInterval = 8 * time.Hour
+	}
+	s := &FuturesServer{
+		cfg:             cfg,
+		api:             newAPI(cfg.APIKey, cfg.SecretKey, cfg.Now),
+		streams:         newUserStreams(),
+		contracts:       map[string]*contract{},
+		wallets:         map[string]amount{},
+		positions:       map[positionKey]*position{},
+		makerCommission: mustAmount(cfg.MakerCommission),
+		takerCommission: mustAmount(cfg.TakerCommission),
+		maintMarginRate: mustAmount(cfg.MaintMarginRate),
+		fundingInterval: int64(cfg.FundingInterval / time.Millisecond),
+	}
+	for _, c := range cfg.Symbols {
+		s.contracts[c.Symbol] = &contract{
+			symbol:      newSymbol(c),
+			leverage:    int64(cfg.Leverage),
+			marginType:  futures.MarginTypeCrossed,
+			fundingRate: mustAmount(cfg.FundingRate),
+		}
+	}
+	for asset, wallet := range cfg.Balances {
+		s.wallets[asset] = mustAmount(wallet)
+	}
+	s.nextFundingTime = (s.api.timestamp()/s.fundingInterval + 1) * s.fundingInterval
+
+	s.handle(http.MethodGet, "/fapi/v1/ping", secTypeNone, func(r *request) (interface{}, error) {
+		return struct{}{}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/time", secTypeNone, func(r *request) (interface{}, error) {
+		return map[string]int64{"serverTime": s.api.timestamp()}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/exchangeInfo", secTypeNone, s.exchangeInfo)
+	s.handle(http.MethodGet, "/fapi/v1/depth", secTypeNone, s.depth)
+	s.handle(http.MethodGet, "/fapi/v1/premiumIndex", secTypeNone, s.premiumIndex)
+	s.handle(http.MethodPost, "/fapi/v1/order", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.createOrder(r.params)
+	})
+	s.handle(http.MethodPut, "/fapi/v1/order", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.modifyOrder(r.params)
+	})
+	s.handle(http.MethodGet, "/fapi/v1/order", secTypeSigned, s.getOrder)
+	s.handle(http.MethodDelete, "/fapi/v1/order", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.cancelOrder(r.params)
+	})
+	s.handle(http.MethodPost, "/fapi/v1/batchOrders", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.batch(r, s.createOrder)
+	})
+	s.handle(http.MethodPut, "/fapi/v1/batchOrders", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.batch(r, s.modifyOrder)
+	})
+	s.handle(http.MethodDelete, "/fapi/v1/batchOrders", secTypeSigned, s.cancelOrders)
+	s.handle(http.MethodGet, "/fapi/v1/openOrder", secTypeSigned, s.getOpenOrder)
+	s.handle(http.MethodGet, "/fapi/v1/openOrders", secTypeSigned, s.openOrders)
+	s.handle(http.MethodDelete, "/fapi/v1/allOpenOrders", secTypeSigned, s.cancelOpenOrders)
+	s.handle(http.MethodGet, "/fapi/v1/allOrders", secTypeSigned, s.allOrders)
+	s.handle(http.MethodGet, "/fapi/v1/userTrades", secTypeSigned, s.userTrades)
+	s.handle(http.MethodGet, "/fapi/v2/positionRisk", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.positionRisk(r, false)
+	})
+	s.handle(http.MethodGet, "/fapi/v3/positionRisk", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.positionRisk(r, true)
+	})
+	s.handle(http.MethodPost, "/fapi/v1/leverage", secTypeSigned, s.changeLeverage)
+	s.handle(http.MethodPost, "/fapi/v1/marginType", secTypeSigned, s.changeMarginType)
+	s.handle(http.MethodGet, "/fapi/v1/positionSide/dual", secTypeSigned, func(r *request) (interface{}, error) {
+		return map[string]bool{"dualSidePosition": s.dualSide}, nil
+	})
+	s.handle(http.MethodPost, "/fapi/v1/positionSide/dual", secTypeSigned, s.changePositionMode)
+	s.handle(http.MethodGet, "/fapi/v1/income", secTypeSigned, s.income)
+	s.handle(http.MethodGet, "/fapi/v2/balance", secTypeSigned, s.balance)
+	s.handle(http.MethodGet, "/fapi/v2/account", secTypeSigned, s.account)
+	s.handle(http.MethodPost, "/fapi/v1/listenKey", secTypeAPIKey, func(r *request) (interface{}, error) {
+		return map[string]string{"listenKey": s.streams.create()}, nil
+	})
+	s.handle(http.MethodPut, "/fapi/v1/listenKey", secTypeAPIKey, func(r *request) (interface{}, error) {
+		key := r.param("listenKey")
+		if !s.streams.exists(key) {
+			return nil, errInvalidListenKey
+		}
+		return map[string]string{"listenKey": key}, nil
+	})
+	s.handle(http.MethodDelete, "/fapi/v1/listenKey", secTypeAPIKey, func(r *request) (interface{}, error) {
+		if !s.streams.remove(r.param("listenKey")) {
+			return nil, errInvalidListenKey
+		}
+		return struct{}{}, nil
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws/", func(w http.ResponseWriter, r *http.Request) {
+		s.streams.serve(w, r, strings.TrimPrefix(r.URL.Path, "/ws/"))
+	})
+	mux.Handle("/", s.api)
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+	s.WsURL = "ws" + strings.TrimPrefix(s.server.URL, "http") + "/ws"
+	return s
+}
+
+// handle register a handler which runs with the state locked and the funding settled
+func (s *FuturesServer) handle(method, path string, sec secType, h handlerFunc) {
+	s.api.handle(method, path, sec, func(r *request) (interface{}, error) {
+		s.lock()
+		defer s.mu.Unlock()
+		return h(r)
+	})
+}
+
+// lock lock the state and settle the fundings due
+func (s *FuturesServer) lock() {
+	s.mu.Lock()
+	now := s.api.timestamp()
+	for s.nextFundingTime <= now {
+		s.fund(s.nextFundingTime)
+		s.nextFundingTime += s.fundingInterval
+	}
+}
+
+// Close close the user data streams and shut down the server
+func (s *FuturesServer) Close() {
+	s.streams.close()
+	s.server.Close()
+}
+
+// Environment return an environment pointing to the server
+func (s *FuturesServer) Environment() *futures.Environment {
+	e := futures.MainnetEnvironment()
+	e.APIURL = s.URL
+	e.WsURL = s.WsURL
+	e.CombinedWsURL = s.WsURL
+	e.WsAPIURL = s.WsURL
+	return e
+}
+
+// NewClient init a client of the account of the server
+func (s *FuturesServer) NewClient() *futures.Client {
+	return futures.NewClient(s.cfg.APIKey, s.cfg.SecretKey, s.Environment())
+}
+
+// SetBalance set the wallet balance of a margin asset and push the account update
+func (s *FuturesServer) SetBalance(asset, wallet string) error {
+	a, err := parseAmount(wallet)
+	if err != nil {
+		return err
+	}
+	s.lock()
+	defer s.mu.Unlock()
+	change := a - s.wallets[asset]
+	s.wallets[asset] = a
+	s.addIncome(asset, "", "TRANSFER", change, 0)
+	s.pushAccountUpdate(futures.UserDataEventReasonTypeDeposit, map[string]amount{asset: change}, nil)
+	return nil
+}
+
+// Balance return the wallet and the available balances of a margin asset
+func (s *FuturesServer) Balance(asset string) (wallet, available string) {
+	s.lock()
+	defer s.mu.Unlock()
+	m := s.margins(asset)
+	return m.wallet.String(), m.available().String()
+}
+
+// SetMarkPrice set the mark price of a symbol, the mark price follows the trades until it is set.
+// MARGIN_CALL is pushed when the maintenance margin reaches 80% of the margin balance.
+func (s *FuturesServer) SetMarkPrice(symbol, price string) error {
+	p, err := parseAmount(price)
+	if err != nil {
+		return err
+	}
+	s.lock()
+	defer s.mu.Unlock()
+	c, ok := s.contracts[symbol]
+	if !ok {
+		return errBadSymbol
+	}
+	c.markPrice, c.markSet = p, true
+	s.checkMarginCall(c.QuoteAsset)
+	return nil
+}
+
+// SetFundingRate set the funding rate of a symbol applied at the next funding times
+func (s *FuturesServer) SetFundingRate(symbol, rate string) error {
+	a, err := parseAmount(rate)
+	if err != nil {
+		return err
+	}
+	s.lock()
+	defer s.mu.Unlock()
+	c, ok := s.contracts[symbol]
+	if !ok {
+		return errBadSymbol
+	}
+	c.fundingRate = a
+	return nil
+}
+
+// AddLiquidity add a GTC limit order of another trader, it matches the resting orders of the account first.
+// It is used to give the account a counterparty.
+func (s *FuturesServer) AddLiquidity(symbol string, side futures.SideType, price, quantity string) (orderID int64, err error) {
+	p, err := parseAmount(price)
+	if err != nil {
+		return 0, err
+	}
+	q, err := parseAmount(quantity)
+	if err != nil {
+		return 0, err
+	}
+	s.lock()
+	defer s.mu.Unlock()
+	c, ok := s.contracts[symbol]
+	if !ok {
+		return 0, errBadSymbol
+	}
+	o := &order{
+		symbol:       symbol,
+		side:         binance.SideType(side),
+		orderType:    binance.OrderTypeLimit,
+		timeInForce:  binance.TimeInForceTypeGTC,
+		price:        p,
+		origQty:      q,
+		liquidity:    true,
+		positionSide: futures.PositionSideTypeBoth,
+	}
+	s.place(c, o, c.book.match(o.side, p, q, 0, c.stepSize), true)
+	return o.id, nil
+}
+
+func (s *FuturesServer) contract(params url.Values) (*contract, error) {
+	name := params.Get("symbol")
+	if name == "" {
+		return nil, errMandatoryParam("symbol")
+	}
+	c, ok := s.contracts[name]
+	if !ok {
+		return nil, errBadSymbol
+	}
+	return c, nil
+}
+
+// optionalContract return the contract of the symbol parameter, nil when it is not sent
+func (s *FuturesServer) optionalContract(r *request) (*contract, error) {
+	if r.param("symbol") == "" {
+		return nil, nil
+	}
+	return s.contract(r.params)
+}
+
+func (s *FuturesServer) position(symbol string, side futures.PositionSideType) *position {
+	k := positionKey{symbol: symbol, side: side}
+	p, ok := s.positions[k]
+	if !ok {
+		p = &position{}
+		s.positions[k] = p
+	}
+	return p
+}
+
+// positionSides return the position sides of the current position mode
+func (s *FuturesServer) positionSides() []futures.PositionSideType {
+	if s.dualSide {
+		return []futures.PositionSideType{futures.PositionSideTypeLong, futures.PositionSideTypeShort}
+	}
+	return []futures.PositionSideType{futures.PositionSideTypeBoth}
+}
+
+// closes report whether an order of side reduces the positions of positionSide in hedge mode
+func closes(side binance.SideType, positionSide futures.PositionSideType) bool {
+	return (positionSide == futures.PositionSideTypeLong && side == binance.SideTypeSell) ||
+		(positionSide == futures.PositionSideTypeShort && side == binance.SideTypeBuy)
+}
+
+// closingQty return the quantity of an order of side that reduces the position
+func closingQty(p *position, side binance.SideType) amount {
+	if (p.amt > 0 && side == binance.SideTypeSell) || (p.amt < 0 && side == binance.SideTypeBuy) {
+		return p.amt.abs()
+	}
+	return 0
+}
+
+// leverageAmount return the leverage of the contract as an amount to divide notionals with
+func (c *contract) leverageAmount() amount {
+	return intAmount(c.leverage)
+}
+
+func (c *contract) unrealized(p *position) amount {
+	return (c.markPrice - p.entryPrice).mul(p.amt)
+}
+
+// apply add the signed quantity delta traded at price to the position and return the realized PnL
+func (p *position) apply(c *contract, delta, price amount) (realized amount) {
+	if p.amt != 0 && (p.amt > 0) != (delta > 0) {
+		closed := minAmount(delta.abs(), p.amt.abs())
+		if p.amt > 0 {
+			realized = (price - p.entryPrice).mul(closed)
+			p.amt -= closed
+			delta += closed
+		} else {
+			realized = (p.entryPrice - price).mul(closed)
+			p.amt += closed
+			delta -= closed
+		}
+		if c.marginType == futures.MarginTypeIsolated {
+			p.isolatedWallet -= p.isolatedWallet.mul(closed).div(p.amt.abs() + closed)
+		}
+		if p.amt == 0 {
+			p.entryPrice, p.isolatedWallet = 0, 0
+		}
+	}
+	if delta != 0 {
+		size, qty := p.amt.abs(), delta.abs()
+		p.entryPrice = (p.entryPrice.mul(size) + price.mul(qty)).div(size + qty)
+		p.amt += delta
+		if c.marginType == futures.MarginTypeIsolated {
+			p.isolatedWallet += price.mul(qty).div(c.leverageAmount())
+		}
+	}
+	p.realized += realized
+	return realized
+}
+
+// assetMargins are the balances and the margins of a margin asset
+type assetMargins struct {
+	wallet           amount
+	crossWallet      amount
+	unrealized       amount
+	crossUnrealized  amount
+	positionInitial  amount
+	crossInitial     amount
+	openOrderInitial amount
+	maint            amount
+}
+
+func (m assetMargins) available() amount {
+	return m.crossWallet + m.crossUnrealized - m.crossInitial - m.openOrderInitial
+}
+
+// openOrderMargin return the initial margin of an open order, orders reducing a position need none
+func (s *FuturesServer) openOrderMargin(c *contract, o *order) amount {
+	if o.reduceOnly || closes(o.side, o.positionSide) {
+		return 0
+	}
+	return o.price.mul(o.remaining()).div(c.leverageAmount())
+}
+
+func (s *FuturesServer) margins(asset string) assetMargins {
+	m := assetMargins{wallet: s.wallets[asset], crossWallet: s.wallets[asset]}
+	for k, p := range s.positions {
+		c := s.contracts[k.symbol]
+		if c.QuoteAsset != asset || p.amt == 0 {
+			continue
+		}
+		upnl := c.unrealized(p)
+		notional := c.markPrice.mul(p.amt).abs()
+		initial := notional.div(c.leverageAmount())
+		m.unrealized += upnl
+		m.positionInitial += initial
+		m.maint += notional.mul(s.maintMarginRate)
+		if c.marginType == futures.MarginTypeIsolated {
+			m.crossWallet -= p.isolatedWallet
+		} else {
+			m.crossUnrealized += upnl
+			m.crossInitial += initial
+		}
+	}
+	for _, o := range s.orders {
+		if c := s.contracts[o.symbol]; o.isOpen() && c.QuoteAsset == asset {
+			m.openOrderInitial += s.openOrderMargin(c, o)
+		}
+	}
+	return m
+}
+
+// eachPosition call f with the open positions of a margin asset in a stable order
+func (s *FuturesServer) eachPosition(asset string, f func(c *contract, side futures.PositionSideType, p *position)) {
+	for _, sc := range s.cfg.Symbols {
+		c := s.contracts[sc.Symbol]
+		if c.QuoteAsset != asset {
+			continue
+		}
+		for _, side := range []futures.PositionSideType{futures.PositionSideTypeBoth, futures.PositionSideTypeLong, futures.PositionSideTypeShort} {
+			if p, ok := s.positions[positionKey{symbol: c.Symbol, side: side}]; ok && p.amt != 0 {
+				f(c, side, p)
+			}
+		}
+	}
+}
+
+func (s *FuturesServer) exchangeInfo(r *request) (interface{}, error) {
+	info := &futures.ExchangeInfo{
+		Timezone:   "UTC",
+		ServerTime: s.api.timestamp(),
+		RateLimits: []futures.RateLimit{
+			{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 2400},
+			{RateLimitType: "ORDERS", Interval: "MINUTE", IntervalNum: 1, Limit: 1200},
+			{RateLimitType: "ORDERS", Interval: "SECOND", IntervalNum: 10, Limit: 300},
+		},
+		ExchangeFilters: []interface{}{},
+		Symbols:         []futures.Symbol{},
+	}
+	for _, sc := range s.cfg.Symbols {
+		c := s.contracts[sc.Symbol]
+		filters := []map[string]interface{}{
+			{
+				"filterType": string(futures.SymbolFilterTypePrice),
+				"minPrice":   c.minPrice.String(),
+				"maxPrice":   c.maxPrice.String(),
+				"tickSize":   c.tickSize.String(),
+			},
+			{
+				"filterType": string(futures.SymbolFilterTypeLotSize),
+				"minQty":     c.minQty.String(),
+				"maxQty":     c.maxQty.String(),
+				"stepSize":   c.stepSize.String(),
+			},
+			{
+				"filterType": string(futures.SymbolFilterTypeMinNotional),
+				"notional":   c.minNotional.String(),
+			},
+		}
+		info.Symbols = append(info.Symbols, futures.Symbol{
+			Symbol:                sc.Symbol,
+			Pair:                  sc.Symbol,
+			ContractType:          futures.ContractTypePerpetual,
+			DeliveryDate:          4133404800000,
+			Status:                string(futures.SymbolStatusTypeTrading),
+			MaintMarginPercent:    s.maintMarginRate.mul(intAmount(100)).String(),
+			RequiredMarginPercent: intAmount(100).div(c.leverageAmount()).String(),
+			PricePrecision:        amountDecimals,
+			QuantityPrecision:     amountDecimals,
+			BaseAssetPrecision:    amountDecimals,
+			QuotePrecision:        amountDecimals,
+			UnderlyingType:        "COIN",
+			UnderlyingSubType:     []string{},
+			OrderType:             []futures.OrderType{futures.OrderTypeLimit, futures.OrderTypeMarket},
+			TimeInForce: []futures.TimeInForceType{futures.TimeInForceTypeGTC, futures.TimeInForceTypeIOC,
+				futures.TimeInForceTypeFOK, futures.TimeInForceTypeGTX},
+			Filters:     filters,
+			QuoteAsset:  sc.QuoteAsset,
+			MarginAsset: sc.QuoteAsset,
+			BaseAsset:   sc.BaseAsset,
+		})
+	}
+	return info, nil
+}
+
+func (s *FuturesServer) depth(r *request) (interface{}, error) {
+	limit, err := r.int64Param("limit", 500)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	levels := func(side binance.SideType) [][2]string {
+		res := [][2]string{}
+		for _, l := range c.book.levels(side, int(limit)) {
+			res = append(res, [2]string{l.price.String(), l.qty.String()})
+		}
+		return res
+	}
+	now := s.api.timestamp()
+	return map[string]interface{}{
+		"lastUpdateId": s.updateID,
+		"E":            now,
+		"T":            now,
+		"bids":         levels(binance.SideTypeBuy),
+		"asks":         levels(binance.SideTypeSell),
+	}, nil
+}
+
+func (s *FuturesServer) premiumIndex(r *request) (interface{}, error) {
+	c, err := s.optionalContract(r)
+	if err != nil {
+		return nil, err
+	}
+	index := func(c *contract) *futures.PremiumIndex {
+		return &futures.PremiumIndex{
+			Symbol:               c.Symbol,
+			MarkPrice:            c.markPrice.String(),
+			IndexPrice:           c.markPrice.String(),
+			EstimatedSettlePrice: c.markPrice.String(),
+			LastFundingRate:      c.fundingRate.String(),
+			NextFundingTime:      s.nextFundingTime,
+			InterestRate:         mustAmount("0.0001").String(),
+			Time:                 s.api.timestamp(),
+		}
+	}
+	if c != nil {
+		return index(c), nil
+	}
+	res := []*futures.PremiumIndex{}
+	for _, sc := range s.cfg.Symbols {
+		res = append(res, index(s.contracts[sc.Symbol]))
+	}
+	return res, nil
+}
+
+func (s *FuturesServer) createOrder(params url.Values) (interface{}, error) {
+	r := &request{params: params}
+	side := binance.SideType(r.param("side"))
+	if side != binance.SideTypeBuy && side != binance.SideTypeSell {
+		return nil, errMandatoryParam("side")
+	}
+	orderType := futures.OrderType(r.param("type"))
+	timeInForce := futures.TimeInForceType(r.param("timeInForce"))
+	price, hasPrice, err := r.amountParam("price")
+	if err != nil {
+		return nil, err
+	}
+	qty, hasQty, err := r.amountParam("quantity")
+	if err != nil {
+		return nil, err
+	}
+	if !hasQty {
+		return nil, errMandatoryParam("quantity")
+	}
+	switch orderType {
+	case futures.OrderTypeLimit:
+		switch timeInForce {
+		case futures.TimeInForceTypeGTC, futures.TimeInForceTypeIOC, futures.TimeInForceTypeFOK, futures.TimeInForceTypeGTX:
+		default:
+			return nil, errMandatoryParam("timeInForce")
+		}
+		if !hasPrice {
+			return nil, errMandatoryParam("price")
+		}
+	case futures.OrderTypeMarket:
+		price, timeInForce = 0, futures.TimeInForceTypeGTC
+	case "":
+		return nil, errMandatoryParam("type")
+	default:
+		return nil, apiError(http.StatusBadRequest, -1116, "Invalid orderType.")
+	}
+	positionSide := futures.PositionSideType(r.param("positionSide"))
+	if positionSide == "" {
+		positionSide = futures.PositionSideTypeBoth
+	}
+	reduceOnly := r.param("reduceOnly") == "true"
+
+	c, err := s.contract(params)
+	if err != nil {
+		return nil, err
+	}
+	if s.dualSide == (positionSide == futures.PositionSideTypeBoth) {
+		return nil, apiError(http.StatusBadRequest, -4061, "Order's position side does not match user's setting.")
+	}
+	if s.dualSide && r.param("reduceOnly") != "" {
+		return nil, apiError(http.StatusBadRequest, -1106, "Parameter 'reduceOnly' sent when not required.")
+	}
+	if err := c.checkFilters(price, qty); err != nil {
+		return nil, err
+	}
+	clientOrderID := r.param("newClientOrderId")
+	if clientOrderID == "" {
+		clientOrderID = common.GenerateSwapId()
+	}
+	for _, o := range s.orders {
+		if o.isOpen() && o.clientOrderID == clientOrderID {
+			return nil, apiError(http.StatusBadRequest, -4015, "Client order id is not valid.")
+		}
+	}
+	// orders reducing a position can not exceed it, in hedge mode this holds for every closing order
+	closing := closingQty(s.position(c.Symbol, positionSide), side)
+	if (reduceOnly || closes(side, positionSide)) && qty > closing {
+		return nil, apiError(http.StatusBadRequest, common.ErrCodeReduceOnlyRejected, "ReduceOnly Order is rejected.")
+	}
+
+	fills := c.book.match(side, price, qty, 0, c.stepSize)
+	var filledQty, filledQuote amount
+	for _, f := range fills {
+		filledQty += f.qty
+		filledQuote += f.price.mul(f.qty)
+	}
+	if timeInForce == futures.TimeInForceTypeGTX && len(fills) > 0 {
+		return nil, apiError(http.StatusBadRequest, common.ErrCodePostOnlyRejected,
+			"Due to the order could not be executed as maker, the Post Only order will be rejected.")
+	}
+	if timeInForce == futures.TimeInForceTypeFOK && filledQty < qty {
+		return nil, apiError(http.StatusBadRequest, common.ErrCodeFOKOrderRejected,
+			"Due to the order could not be filled immediately, the FOK order has been rejected.")
+	}
+	// the quantity opening a position needs initial margin, at the order price or the fill prices for market orders
+	if increase := qty - closing; increase > 0 {
+		estimate := price
+		if orderType == futures.OrderTypeMarket {
+			estimate = c.markPrice
+			if filledQty > 0 {
+				estimate = filledQuote.div(filledQty)
+			}
+		}
+		if estimate.mul(increase).div(c.leverageAmount()) > s.margins(c.QuoteAsset).available() {
+			return nil, apiError(http.StatusBadRequest, common.ErrCodeMarginNotSufficient, "Margin is insufficient.")
+		}
+	}
+
+	o := &order{
+		clientOrderID: clientOrderID,
+		symbol:        c.Symbol,
+		side:          side,
+		orderType:     binance.OrderType(orderType),
+		timeInForce:   binance.TimeInForceType(timeInForce),
+		price:         price,
+		origQty:       qty,
+		positionSide:  positionSide,
+		reduceOnly:    reduceOnly,
+	}
+	rest := orderType == futures.OrderTypeLimit &&
+		(timeInForce == futures.TimeInForceTypeGTC || timeInForce == futures.TimeInForceTypeGTX)
+	s.place(c, o, fills, rest)
+	return s.newOrderResponse(o), nil
+}
+
+// place execute the fills of a new order and rest what remains of it on the book when rest is true
+func (s *FuturesServer) place(c *contract, o *order, fills []fill, rest bool) {
+	now := s.api.timestamp()
+	s.nextOrderID++
+	o.id = s.nextOrderID
+	o.status = binance.OrderStatusTypeNew
+	o.time, o.updateTime = now, now
+	if !o.liquidity {
+		s.orders = append(s.orders, o)
+		s.pushOrderTradeUpdate(o, futures.OrderExecutionTypeNew, nil)
+	}
+	filled := s.execute(c, o, fills)
+	switch {
+	case rest && o.remaining() > 0:
+		c.book.add(o)
+	case o.status != binance.OrderStatusTypeFilled:
+		o.status = binance.OrderStatusTypeExpired
+		if !o.liquidity {
+			s.pushOrderTradeUpdate(o, futures.OrderExecutionTypeExpired, nil)
+		}
+	}
+	s.updateID++
+	if filled {
+		s.pushAccountUpdate(futures.UserDataEventReasonTypeOrder, nil, map[string]bool{c.Symbol: true})
+	}
+}
+
+// execute trade the fills of the taker order o, it reports whether an account order was filled
+func (s *FuturesServer) execute(c *contract, o *order, fills []fill) (filled bool) {
+	now := s.api.timestamp()
+	for _, f := range fills {
+		s.nextTradeID++
+		if !c.markSet {
+			c.markPrice = f.price
+		}
+		quote := f.price.mul(f.qty)
+		maker := f.maker
+		maker.executedQty += f.qty
+		maker.cumQuote += quote
+		maker.updateTime = now
+		maker.status = binance.OrderStatusTypePartiallyFilled
+		if maker.remaining() == 0 {
+			maker.status = binance.OrderStatusTypeFilled
+			c.book.remove(maker)
+		}
+		o.executedQty += f.qty
+		o.cumQuote += quote
+		o.updateTime = now
+		o.status = binance.OrderStatusTypePartiallyFilled
+		if o.remaining() == 0 {
+			o.status = binance.OrderStatusTypeFilled
+		}
+		if !maker.liquidity {
+			s.pushOrderTradeUpdate(maker, futures.OrderExecutionTypeTrade, s.settle(c, maker, true, f))
+			filled = true
+		}
+		if !o.liquidity {
+			s.pushOrderTradeUpdate(o, futures.OrderExecutionTypeTrade, s.settle(c, o, false, f))
+			filled = true
+		}
+	}
+	return filled
+}
+
+// settle update the position of an account order for a fill, charge the commission and record the trade
+func (s *FuturesServer) settle(c *contract, o *order, isMaker bool, f fill) *futures.AccountTrade {
+	rate := s.takerCommission
+	if isMaker {
+		rate = s.makerCommission
+	}
+	quote := f.price.mul(f.qty)
+	commission := quote.mul(rate)
+	delta := f.qty
+	if o.side == binance.SideTypeSell {
+		delta = -f.qty
+	}
+	p := s.position(c.Symbol, o.positionSide)
+	realized := p.apply(c, delta, f.price)
+	p.updateTime = o.updateTime
+	s.wallets[c.QuoteAsset] += realized - commission
+	t := &futures.AccountTrade{
+		Buyer:           o.side == binance.SideTypeBuy,
+		Commission:      commission.String(),
+		CommissionAsset: c.QuoteAsset,
+		ID:              s.nextTradeID,
+		Maker:           isMaker,
+		OrderID:         o.id,
+		Price:           f.price.String(),
+		Quantity:        f.qty.String(),
+		QuoteQuantity:   quote.String(),
+		RealizedPnl:     realized.String(),
+		Side:            futures.SideType(o.side),
+		PositionSide:    o.positionSide,
+		Symbol:          c.Symbol,
+		Time:            o.updateTime,
+	}
+	s.trades = append(s.trades, t)
+	if realized != 0 {
+		s.addIncome(c.QuoteAsset, c.Symbol, "REALIZED_PNL", realized, t.ID)
+	}
+	if commission != 0 {
+		s.addIncome(c.QuoteAsset, c.Symbol, "COMMISSION", -commission, t.ID)
+	}
+	return t
+}
+
+func (s *FuturesServer) addIncome(asset, symbol, incomeType string, income amount, tradeID int64) *futures.IncomeHistory {
+	s.nextTranID++
+	h := &futures.IncomeHistory{
+		Asset:      asset,
+		Income:     income.String(),
+		IncomeType: incomeType,
+		Symbol:     symbol,
+		Time:       s.api.timestamp(),
+		TranID:     s.nextTranID,
+	}
+	if tradeID != 0 {
+		h.TradeID = strconv.FormatInt(tradeID, 10)
+	}
+	s.incomes = append(s.incomes, h)
+	return h
+}
+
+// fund settle the funding of the open positions at the funding time t.
+// Long positions pay short positions when the funding rate is positive.
+func (s *FuturesServer) fund(t int64) {
+	changes := map[string]amount{}
+	symbols := map[string]bool{}
+	for _, asset := range s.assets() {
+		s.eachPosition(asset, func(c *contract, side futures.PositionSideType, p *position) {
+			payment := -c.markPrice.mul(p.amt).mul(c.fundingRate)
+			if payment == 0 {
+				return
+			}
+			s.wallets[asset] += payment
+			if c.marginType == futures.MarginTypeIsolated {
+				p.isolatedWallet += payment
+			}
+			changes[asset] += payment
+			symbols[c.Symbol] = true
+			s.addIncome(asset, c.Symbol, "FUNDING_FEE", payment, 0).Time = t
+		})
+	}
+	if len(symbols) > 0 {
+		s.pushAccountUpdate(futures.UserDataEventReasonTypeFundingFee, changes, symbols)
+	}
+}
+
+// findOrder return the account order of orderId or origClientOrderId
+func (s *FuturesServer) findOrder(params url.Values) (*contract, *order, error) {
+	c, err := s.contract(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, err := findOrder(s.orders, &request{params: params}, c.symbol)
+	return c, o, err
+}
+
+func (s *FuturesServer) getOrder(r *request) (interface{}, error) {
+	_, o, err := s.findOrder(r.params)
+	if err != nil {
+		return nil, err
+	}
+	return s.newOrderResponse(o), nil
+}
+
+func (s *FuturesServer) getOpenOrder(r *request) (interface{}, error) {
+	_, o, err := s.findOrder(r.params)
+	if err == nil && !o.isOpen() {
+		err = errNoSuchOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.newOrderResponse(o), nil
+}
+
+// modifyOrder change the price and the quantity of an open limit order, which loses its priority
+// and may trade at once. The order is canceled when the new quantity is not above the executed one.
+func (s *FuturesServer) modifyOrder(params url.Values) (interface{}, error) {
+	r := &request{params: params}
+	c, o, err := s.findOrder(params)
+	if err != nil {
+		return nil, err
+	}
+	if !o.isOpen() || o.orderType != binance.OrderTypeLimit {
+		return nil, errUnknownOrder
+	}
+	if binance.SideType(r.param("side")) != o.side {
+		return nil, errInvalidParam("side")
+	}
+	price, hasPrice, err := r.amountParam("price")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPrice {
+		return nil, errMandatoryParam("price")
+	}
+	qty, hasQty, err := r.amountParam("quantity")
+	if err != nil {
+		return nil, err
+	}
+	if !hasQty {
+		return nil, errMandatoryParam("quantity")
+	}
+	if err := c.checkFilters(price, qty); err != nil {
+		return nil, err
+	}
+	if price == o.price && qty == o.origQty {
+		return nil, apiError(http.StatusBadRequest, -5027, "No need to modify the order.")
+	}
+	if qty <= o.executedQty {
+		s.cancel(c, o)
+		return s.newOrderResponse(o), nil
+	}
+	c.book.remove(o)
+	o.price, o.origQty = price, qty
+	o.updateTime = s.api.timestamp()
+	s.pushOrderTradeUpdate(o, futures.OrderExecutionType("AMENDMENT"), nil)
+	filled := s.execute(c, o, c.book.match(o.side, price, o.remaining(), 0, c.stepSize))
+	if o.remaining() > 0 {
+		c.book.add(o)
+	}
+	s.updateID++
+	if filled {
+		s.pushAccountUpdate(futures.UserDataEventReasonTypeOrder, nil, map[string]bool{c.Symbol: true})
+	}
+	return s.newOrderResponse(o), nil
+}
+
+func (s *FuturesServer) cancelOrder(params url.Values) (interface{}, error) {
+	c, o, err := s.findOrder(params)
+	if err == errNoSuchOrder || (err == nil && !o.isOpen()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.cancel(c, o)
+	return s.newOrderResponse(o), nil
+}
+
+func (s *FuturesServer) cancel(c *contract, o *order) {
+	o.status = binance.OrderStatusTypeCanceled
+	o.updateTime = s.api.timestamp()
+	c.book.remove(o)
+	s.updateID++
+	s.pushOrderTradeUpdate(o, futures.OrderExecutionTypeCanceled, nil)
+}
+
+// batch run f with every order of the batchOrders parameter, the result of each order is either the order or an error
+func (s *FuturesServer) batch(r *request, f func(params url.Values) (interface{}, error)) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(r.param("batchOrders")))
+	dec.UseNumber()
+	var orders []map[string]interface{}
+	if err := dec.Decode(&orders); err != nil || len(orders) == 0 {
+		return nil, errMandatoryParam("batchOrders")
+	}
+	if len(orders) > 5 {
+		return nil, apiError(http.StatusBadRequest, -4082, "Invalid number of batch place orders.")
+	}
+	res := make([]interface{}, 0, len(orders))
+	for _, m := range orders {
+		params := url.Values{}
+		for k, v := range m {
+			switch v := v.(type) {
+			case nil:
+			case string:
+				params.Set(k, v)
+			default:
+				params.Set(k, fmt.Sprint(v))
+			}
+		}
+		o, err := f(params)
+		if err != nil {
+			res = append(res, common.AsAPIError(err))
+			continue
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+
+// listParam parse a list parameter sent as a JSON array or formatted like "[a b]"
+func listParam(r *request, name string) []string {
+	v := r.param(name)
+	if v == "" {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(v), &list); err == nil {
+		return list
+	}
+	return strings.FieldsFunc(strings.Trim(v, "[]"), func(c rune) bool {
+		return c == ',' || c == ' ' || c == '"'
+	})
+}
+
+func (s *FuturesServer) cancelOrders(r *request) (interface{}, error) {
+	ids, clientOrderIDs := listParam(r, "orderIdList"), listParam(r, "origClientOrderIdList")
+	if len(ids) == 0 && len(clientOrderIDs) == 0 {
+		return nil, apiError(http.StatusBadRequest, -1102, "Param 'orderIdList' or 'origClientOrderIdList' must be sent, but both were empty/null!")
+	}
+	res := []interface{}{}
+	cancel := func(key, value string) {
+		params := url.Values{"symbol": {r.param("symbol")}, key: {value}}
+		o, err := s.cancelOrder(params)
+		if err != nil {
+			res = append(res, common.AsAPIError(err))
+			return
+		}
+		res = append(res, o)
+	}
+	for _, id := range ids {
+		cancel("orderId", id)
+	}
+	for _, id := range clientOrderIDs {
+		cancel("origClientOrderId", id)
+	}
+	return res, nil
+}
+
+func (s *FuturesServer) openOrders(r *request) (interface{}, error) {
+	c, err := s.optionalContract(r)
+	if err != nil {
+		return nil, err
+	}
+	res := []*futures.Order{}
+	for _, o := range s.orders {
+		if o.isOpen() && (c == nil || o.symbol == c.Symbol) {
+			res = append(res, s.newOrderResponse(o))
+		}
+	}
+	return res, nil
+}
+
+func (s *FuturesServer) cancelOpenOrders(r *request) (interface{}, error) {
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range s.orders {
+		if o.isOpen() && o.symbol == c.Symbol {
+			s.cancel(c, o)
+		}
+	}
+	return map[string]interface{}{"code": 200, "msg": "The operation of cancel all open order is done."}, nil
+}
+
+func (s *FuturesServer) allOrders(r *request) (interface{}, error) {
+	startTime, endTime, limit, err := timeRange(r, 500)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := r.int64Param("orderId", 0)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	res := []*futures.Order{}
+	for _, o := range s.orders {
+		if o.symbol == c.Symbol && o.id >= orderID && inRange(o.time, startTime, endTime) {
+			res = append(res, s.newOrderResponse(o))
+		}
+	}
+	from, to := page(len(res), limit, r.param("orderId") != "" || startTime != 0)
+	return res[from:to], nil
+}
+
+func (s *FuturesServer) userTrades(r *request) (interface{}, error) {
+	startTime, endTime, limit, err := timeRange(r, 500)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := r.int64Param("orderId", 0)
+	if err != nil {
+		return nil, err
+	}
+	fromID, err := r.int64Param("fromId", 0)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	res := []*futures.AccountTrade{}
+	for _, t := range s.trades {
+		if t.Symbol == c.Symbol && (orderID == 0 || t.OrderID == orderID) && t.ID >= fromID &&
+			inRange(t.Time, startTime, endTime) {
+			res = append(res, t)
+		}
+	}
+	from, to := page(len(res), limit, r.param("fromId") != "" || startTime != 0)
+	return res[from:to], nil
+}
+
+// marginTypeName return the margin type as written in the position payloads
+func marginTypeName(t futures.MarginType) string {
+	if t == futures.MarginTypeIsolated {
+		return "isolated"
+	}
+	return "cross"
+}
+
+// positionRisk list the positions of the current position mode, only the open ones for v3
+func (s *FuturesServer) positionRisk(r *request, v3 bool) (interface{}, error) {
+	only, err := s.optionalContract(r)
+	if err != nil {
+		return nil, err
+	}
+	var v2Res []*futures.PositionRisk
+	var v3Res []*futures.PositionRiskV3
+	for _, sc := range s.cfg.Symbols {
+		c := s.contracts[sc.Symbol]
+		if only != nil && c != only {
+			continue
+		}
+		for _, side := range s.positionSides() {
+			p := s.position(c.Symbol, side)
+			if v3 && p.amt == 0 {
+				continue
+			}
+			notional := c.markPrice.mul(p.amt)
+			upnl := c.unrealized(p)
+			isolatedMargin := amount(0)
+			if c.marginType == futures.MarginTypeIsolated {
+				isolatedMargin = p.isolatedWallet + upnl
+			}
+			if !v3 {
+				v2Res = append(v2Res, &futures.PositionRisk{
+					EntryPrice:       p.entryPrice.String(),
+					BreakEvenPrice:   p.entryPrice.String(),
+					MarginType:       marginTypeName(c.marginType),
+					IsAutoAddMargin:  "false",
+					IsolatedMargin:   isolatedMargin.String(),
+					Leverage:         strconv.FormatInt(c.leverage, 10),
+					LiquidationPrice: amount(0).String(),
+					MarkPrice:        c.markPrice.String(),
+					MaxNotionalValue: "1000000",
+					PositionAmt:      p.amt.String(),
+					Symbol:           c.Symbol,
+					UnRealizedProfit: upnl.String(),
+					PositionSide:     string(side),
+					Notional:         notional.String(),
+					IsolatedWallet:   p.isolatedWallet.String(),
+				})
+				continue
+			}
+			openOrderInitial := amount(0)
+			for _, o := range s.orders {
+				if o.isOpen() && o.symbol == c.Symbol && o.positionSide == side {
+					openOrderInitial += s.openOrderMargin(c, o)
+				}
+			}
+			initial := notional.abs().div(c.leverageAmount())
+			v3Res = append(v3Res, &futures.PositionRiskV3{
+				Symbol:                 c.Symbol,
+				PositionSide:           string(side),
+				PositionAmt:            p.amt.String(),
+				EntryPrice:             p.entryPrice.String(),
+				BreakEvenPrice:         p.entryPrice.String(),
+				MarkPrice:              c.markPrice.String(),
+				UnRealizedProfit:       upnl.String(),
+				LiquidationPrice:       amount(0).String(),
+				IsolatedMargin:         isolatedMargin.String(),
+				Notional:               notional.String(),
+				MarginAsset:            c.QuoteAsset,
+				IsolatedWallet:         p.isolatedWallet.String(),
+				InitialMargin:          (initial + openOrderInitial).String(),
+				MaintMargin:            notional.abs().mul(s.maintMarginRate).String(),
+				PositionInitialMargin:  initial.String(),
+				OpenOrderInitialMargin: openOrderInitial.String(),
+				BidNotional:            amount(0).String(),
+				AskNotional:            amount(0).String(),
+				UpdateTime:             p.updateTime,
+			})
+		}
+	}
+	if v3 {
+		if v3Res == nil {
+			v3Res = []*futures.PositionRiskV3{}
+		}
+		return v3Res, nil
+	}
+	return v2Res, nil
+}
+
+// hasPositionOrOrders report whether the symbol, or any symbol when c is nil, has a position or an open order
+func (s *FuturesServer) hasPositionOrOrders(c *contract) (hasPosition, hasOrders bool) {
+	for k, p := range s.positions {
+		if p.amt != 0 && (c == nil || k.symbol == c.Symbol) {
+			hasPosition = true
+		}
+	}
+	for _, o := range s.orders {
+		if o.isOpen() && (c == nil || o.symbol == c.Symbol) {
+			hasOrders = true
+		}
+	}
+	return hasPosition, hasOrders
+}
+
+func (s *FuturesServer) changeLeverage(r *request) (interface{}, error) {
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	leverage, err := r.int64Param("leverage", 0)
+	if err != nil {
+		return nil, err
+	}
+	if leverage < 1 || leverage > 125 {
+		return nil, apiError(http.StatusBadRequest, -4028, "Leverage %d is not valid", leverage)
+	}
+	c.leverage = leverage
+	s.pushEvent(&futuresEvent{
+		Event: futures.UserDataEventTypeAccountConfigUpdate,
+		WsUserDataAccountConfigUpdate: &futures.WsUserDataAccountConfigUpdate{
+			AccountConfigUpdate: futures.WsAccountConfigUpdate{Symbol: c.Symbol, Leverage: leverage},
+		},
+	})
+	return &futures.SymbolLeverage{Leverage: int(leverage), MaxNotionalValue: "1000000", Symbol: c.Symbol}, nil
+}
+
+func (s *FuturesServer) changeMarginType(r *request) (interface{}, error) {
+	c, err := s.contract(r.params)
+	if err != nil {
+		return nil, err
+	}
+	marginType := futures.MarginType(r.param("marginType"))
+	if marginType != futures.MarginTypeIsolated && marginType != futures.MarginTypeCrossed {
+		return nil, errMandatoryParam("marginType")
+	}
+	if marginType == c.marginType {
+		return nil, apiError(http.StatusBadRequest, -4046, "No need to change margin type.")
+	}
+	if hasPosition, hasOrders := s.hasPositionOrOrders(c); hasPosition || hasOrders {
+		return nil, apiError(http.StatusBadRequest, -4048, "Margin type cannot be changed if there exists position.")
+	}
+	c.marginType = marginType
+	return map[string]interface{}{"code": 200, "msg": "success"}, nil
+}
+
+func (s *FuturesServer) changePositionMode(r *request) (interface{}, error) {
+	dualSide, err := strconv.ParseBool(r.param("dualSidePosition"))
+	if err != nil {
+		return nil, errMandatoryParam("dualSidePosition")
+	}
+	if dualSide == s.dualSide {
+		return nil, apiError(http.StatusBadRequest, -4059, "No need to change position side.")
+	}
+	hasPosition, hasOrders := s.hasPositionOrOrders(nil)
+	if hasOrders {
+		return nil, apiError(http.StatusBadRequest, -4067, "Position side cannot be changed if there exists open orders.")
+	}
+	if hasPosition {
+		return nil, apiError(http.StatusBadRequest, -4068, "Position side cannot be changed if there exists position.")
+	}
+	s.dualSide = dualSide
+	return map[string]interface{}{"code": 200, "msg": "success"}, nil
+}
+
+func (s *FuturesServer) income(r *request) (interface{}, error) {
+	startTime, endTime, limit, err := timeRange(r, 100)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.optionalContract(r)
+	if err != nil {
+		return nil, err
+	}
+	incomeType := r.param("incomeType")
+	res := []*futures.IncomeHistory{}
+	for _, h := range s.incomes {
+		if (c == nil || h.Symbol == c.Symbol) && (incomeType == "" || h.IncomeType == incomeType) &&
+			inRange(h.Time, startTime, endTime) {
+			res = append(res, h)
+		}
+	}
+	from, to := page(len(res), limit, startTime != 0)
+	return res[from:to], nil
+}
+
+// assets return the margin assets of the account sorted by name
+func (s *FuturesServer) assets() []string {
+	seen := map[string]bool{}
+	for asset := range s.wallets {
+		seen[asset] = true
+	}
+	for _, c := range s.contracts {
+		seen[c.QuoteAsset] = true
+	}
+	assets := make([]string, 0, len(seen))
+	for asset := range seen {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (s *FuturesServer) balance(r *request) (interface{}, error) {
+	res := []*futures.Balance{}
+	for _, asset := range s.assets() {
+		m := s.margins(asset)
+		res = append(res, &futures.Balance{
+			AccountAlias:       "fake",
+			Asset:              asset,
+			Balance:            m.wallet.String(),
+			CrossWalletBalance: m.crossWallet.String(),
+			CrossUnPnl:         m.crossUnrealized.String(),
+			AvailableBalance:   m.available().String(),
+			MaxWithdrawAmount:  m.available().String(),
+		})
+	}
+	return res, nil
+}
+
+func (s *FuturesServer) account(r *request) (interface{}, error) {
+	now := s.api.timestamp()
+	res := &futures.Account{
+		Assets:      []*futures.AccountAsset{},
+		CanTrade:    true,
+		CanDeposit:  true,
+		CanWithdraw: true,
+		UpdateTime:  now,
+		Positions:   []*futures.AccountPosition{},
+	}
+	var total assetMargins
+	for _, asset := range s.assets() {
+		m := s.margins(asset)
+		total.wallet += m.wallet
+		total.crossWallet += m.crossWallet
+		total.unrealized += m.unrealized
+		total.crossUnrealized += m.crossUnrealized
+		total.positionInitial += m.positionInitial
+		total.crossInitial += m.crossInitial
+		total.openOrderInitial += m.openOrderInitial
+		total.maint += m.maint
+		res.Assets = append(res.Assets, &futures.AccountAsset{
+			Asset:                  asset,
+			InitialMargin:          (m.positionInitial + m.openOrderInitial).String(),
+			MaintMargin:            m.maint.String(),
+			MarginBalance:          (m.wallet + m.unrealized).String(),
+			MaxWithdrawAmount:      m.available().String(),
+			OpenOrderInitialMargin: m.openOrderInitial.String(),
+			PositionInitialMargin:  m.positionInitial.String(),
+			UnrealizedProfit:       m.unrealized.String(),
+			WalletBalance:          m.wallet.String(),
+			CrossWalletBalance:     m.crossWallet.String(),
+			CrossUnPnl:             m.crossUnrealized.String(),
+			AvailableBalance:       m.available().String(),
+			MarginAvailable:        true,
+			UpdateTime:             now,
+		})
+	}
+	res.TotalInitialMargin = (total.positionInitial + total.openOrderInitial).String()
+	res.TotalMaintMargin = total.maint.String()
+	res.TotalWalletBalance = total.wallet.String()
+	res.TotalUnrealizedProfit = total.unrealized.String()
+	res.TotalMarginBalance = (total.wallet + total.unrealized).String()
+	res.TotalPositionInitialMargin = total.positionInitial.String()
+	res.TotalOpenOrderInitialMargin = total.openOrderInitial.String()
+	res.TotalCrossWalletBalance = total.crossWallet.String()
+	res.TotalCrossUnPnl = total.crossUnrealized.String()
+	res.AvailableBalance = total.available().String()
+	res.MaxWithdrawAmount = total.available().String()
+	for _, sc := range s.cfg.Symbols {
+		c := s.contracts[sc.Symbol]
+		for _, side := range s.positionSides() {
+			p := s.position(c.Symbol, side)
+			notional := c.markPrice.mul(p.amt)
+			initial := notional.abs().div(c.leverageAmount())
+			res.Positions = append(res.Positions, &futures.AccountPosition{
+				Isolated:               c.marginType == futures.MarginTypeIsolated,
+				Leverage:               strconv.FormatInt(c.leverage, 10),
+				InitialMargin:          initial.String(),
+				MaintMargin:            notional.abs().mul(s.maintMarginRate).String(),
+				OpenOrderInitialMargin: amount(0).String(),
+				PositionInitialMargin:  initial.String(),
+				Symbol:                 c.Symbol,
+				UnrealizedProfit:       c.unrealized(p).String(),
+				EntryPrice:             p.entryPrice.String(),
+				MaxNotional:            "1000000",
+				PositionSide:           side,
+				PositionAmt:            p.amt.String(),
+				Notional:               notional.String(),
+				BidNotional:            amount(0).String(),
+				AskNotional:            amount(0).String(),
+				UpdateTime:             p.updateTime,
+			})
+		}
+	}
+	return res, nil
+}
+
+func (s *FuturesServer) newOrderResponse(o *order) *futures.Order {
+	return &futures.Order{
+		Symbol:                  o.symbol,
+		OrderID:                 o.id,
+		ClientOrderID:           o.clientOrderID,
+		Price:                   o.price.String(),
+		ReduceOnly:              o.reduceOnly,
+		OrigQuantity:            o.origQty.String(),
+		ExecutedQuantity:        o.executedQty.String(),
+		CumQuantity:             o.executedQty.String(),
+		CumQuote:                o.cumQuote.String(),
+		Status:                  futures.OrderStatusType(o.status),
+		TimeInForce:             futures.TimeInForceType(o.timeInForce),
+		Type:                    futures.OrderType(o.orderType),
+		Side:                    futures.SideType(o.side),
+		StopPrice:               amount(0).String(),
+		Time:                    o.time,
+		UpdateTime:              o.updateTime,
+		WorkingType:             futures.WorkingTypeContractPrice,
+		AvgPrice:                o.cumQuote.div(o.executedQty).String(),
+		OrigType:                futures.OrderType(o.orderType),
+		PositionSide:            o.positionSide,
+		PriceMatch:              string(futures.PriceMatchTypeNone),
+		SelfTradePreventionMode: "NONE",
+	}
+}
+
+// futuresEvent is a user data event, the payload is the one embedded field which is set
+type futuresEvent struct {
+	Event           futures.UserDataEventType `json:"e"`
+	Time            int64                     `json:"E"`
+	TransactionTime int64                     `json:"T,omitempty"`
+	*futures.WsUserDataOrderTradeUpdate
+	*futures.WsUserDataAccountUpdate
+	*futures.WsUserDataAccountConfigUpdate
+	*futures.WsUserDataMarginCall
+}
+
+func (s *FuturesServer) pushEvent(e *futuresEvent) {
+	e.Time = s.api.timestamp()
+	if e.Event != futures.UserDataEventTypeMarginCall {
+		e.TransactionTime = e.Time
+	}
+	s.streams.push(e)
+}
+
+// pushOrderTradeUpdate push the ORDER_TRADE_UPDATE of an account order, t is the trade of TRADE executions
+func (s *FuturesServer) pushOrderTradeUpdate(o *order, executionType futures.OrderExecutionType, t *futures.AccountTrade) {
+	u := futures.WsOrderTradeUpdate{
+		Symbol:               o.symbol,
+		ClientOrderID:        o.clientOrderID,
+		Side:                 futures.SideType(o.side),
+		Type:                 futures.OrderType(o.orderType),
+		TimeInForce:          futures.TimeInForceType(o.timeInForce),
+		OriginalQty:          o.origQty.String(),
+		OriginalPrice:        o.price.String(),
+		AveragePrice:         o.cumQuote.div(o.executedQty).String(),
+		StopPrice:            amount(0).String(),
+		ExecutionType:        executionType,
+		Status:               futures.OrderStatusType(o.status),
+		ID:                   o.id,
+		LastFilledQty:        amount(0).String(),
+		AccumulatedFilledQty: o.executedQty.String(),
+		LastFilledPrice:      amount(0).String(),
+		TradeTime:            o.updateTime,
+		BidsNotional:         amount(0).String(),
+		AsksNotional:         amount(0).String(),
+		IsReduceOnly:         o.reduceOnly,
+		WorkingType:          futures.WorkingTypeContractPrice,
+		OriginalType:         futures.OrderType(o.orderType),
+		PositionSide:         o.positionSide,
+		RealizedPnL:          amount(0).String(),
+		STP:                  "NONE",
+		PriceMode:            string(futures.PriceMatchTypeNone),
+	}
+	if t != nil {
+		u.LastFilledQty = t.Quantity
+		u.LastFilledPrice = t.Price
+		u.CommissionAsset = t.CommissionAsset
+		u.Commission = t.Commission
+		u.TradeID = t.ID
+		u.IsMaker = t.Maker
+		u.RealizedPnL = t.RealizedPnl
+	}
+	s.pushEvent(&futuresEvent{
+		Event:                      futures.UserDataEventTypeOrderTradeUpdate,
+		WsUserDataOrderTradeUpdate: &futures.WsUserDataOrderTradeUpdate{OrderTradeUpdate: u},
+	})
+}
+
+func (s *FuturesServer) wsPosition(c *contract, side futures.PositionSideType, p *position) futures.WsPosition {
+	return futures.WsPosition{
+		Symbol:                    c.Symbol,
+		Side:                      side,
+		Amount:                    p.amt.String(),
+		MarginType:                futures.MarginType(marginTypeName(c.marginType)),
+		IsolatedWallet:            p.isolatedWallet.String(),
+		EntryPrice:                p.entryPrice.String(),
+		MarkPrice:                 c.markPrice.String(),
+		UnrealizedPnL:             c.unrealized(p).String(),
+		AccumulatedRealized:       p.realized.String(),
+		MaintenanceMarginRequired: c.markPrice.mul(p.amt).abs().mul(s.maintMarginRate).String(),
+	}
+}
+
+// pushAccountUpdate push the ACCOUNT_UPDATE of the balances of the changed assets and the margin assets of symbols,
+// and of the positions of symbols. changes are the balance changes other than PnL and commissions.
+func (s *FuturesServer) pushAccountUpdate(reason futures.UserDataEventReasonType, changes map[string]amount, symbols map[string]bool) {
+	u := futures.WsAccountUpdate{Reason: reason, Balances: []futures.WsBalance{}, Positions: []futures.WsPosition{}}
+	assets := map[string]bool{}
+	for asset := range changes {
+		assets[asset] = true
+	}
+	for symbol := range symbols {
+		assets[s.contracts[symbol].QuoteAsset] = true
+	}
+	for _, asset := range s.assets() {
+		if !assets[asset] {
+			continue
+		}
+		m := s.margins(asset)
+		u.Balances = append(u.Balances, futures.WsBalance{
+			Asset:              asset,
+			Balance:            m.wallet.String(),
+			CrossWalletBalance: m.crossWallet.String(),
+			ChangeBalance:      changes[asset].String(),
+		})
+	}
+	for _, sc := range s.cfg.Symbols {
+		if !symbols[sc.Symbol] {
+			continue
+		}
+		c := s.contracts[sc.Symbol]
+		for _, side := range s.positionSides() {
+			u.Positions = append(u.Positions, s.wsPosition(c, side, s.position(c.Symbol, side)))
+		}
+	}
+	s.pushEvent(&futuresEvent{
+		Event:                   futures.UserDataEventTypeAccountUpdate,
+		WsUserDataAccountUpdate: &futures.WsUserDataAccountUpdate{AccountUpdate: u},
+	})
+}
+
+// checkMarginCall push MARGIN_CALL with the positions of a margin asset whose maintenance margin
+// reaches 80% of their margin balance, the cross margin balance for cross positions
+func (s *FuturesServer) checkMarginCall(asset string) {
+	m := s.margins(asset)
+	var cross, calls []futures.WsPosition
+	var crossMaint amount
+	s.eachPosition(asset, func(c *contract, side futures.PositionSideType, p *position) {
+		maint := c.markPrice.mul(p.amt).abs().mul(s.maintMarginRate)
+		if c.marginType == futures.MarginTypeIsolated {
+			if maint >= (p.isolatedWallet + c.unrealized(p)).mul(marginCallRatio) {
+				calls = append(calls, s.wsPosition(c, side, p))
+			}
+			return
+		}
+		crossMaint += maint
+		cross = append(cross, s.wsPosition(c, side, p))
+	})
+	if crossMaint > 0 && crossMaint >= (m.crossWallet+m.crossUnrealized).mul(marginCallRatio) {
+		calls = append(cross, calls...)
+	}
+	if len(calls) == 0 {
+		return
+	}
+	s.pushEvent(&futuresEvent{
+		Event: futures.UserDataEventTypeMarginCall,
+		WsUserDataMarginCall: &futures.WsUserDataMarginCall{
+			CrossWalletBalance:  m.crossWallet.String(),
+			MarginCallPositions: calls,
+		},
+	})
+}
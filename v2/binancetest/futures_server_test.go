@@ -0,0 +1,312 @@
+package binancetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// testClock is a clock the tests move forward
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+type futuresServerTestSuite struct {
+	suite.Suite
+	clock  *testClock
+	server *FuturesServer
+	client *futures.Client
+	events chan *futures.WsUserDataEvent
+	stopC  chan struct{}
+}
+
+func TestFuturesServer(t *testing.T) {
+	suite.Run(t, new(futuresServerTestSuite))
+}
+
+func (s *futuresServerTestSuite) SetupTest() {
+	s.clock = &testClock{now: time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)}
+	s.server = NewFuturesServer(FuturesConfig{
+		APIKey:          "apiKey",
+		SecretKey:       "secretKey",
+		Balances:        map[string]string{"USDT": "10000"},
+		MakerCommission: "0.0002",
+		TakerCommission: "0.0005",
+		Leverage:        10,
+		Now:             s.clock.Now,
+	})
+	s.client = s.server.NewClient()
+	s.syncTime()
+
+	listenKey, err := s.client.NewStartUserStreamService().Do(context.Background())
+	s.Require().NoError(err)
+	events := make(chan *futures.WsUserDataEvent, 100)
+	s.events = events
+	_, s.stopC, err = s.client.Environment.WsUserDataServe(listenKey, func(event *futures.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+}
+
+func (s *futuresServerTestSuite) TearDownTest() {
+	close(s.stopC)
+	s.server.Close()
+}
+
+// syncTime set the time offset of the client to the clock of the server
+func (s *futuresServerTestSuite) syncTime() {
+	_, err := s.client.NewSetServerTimeService().Do(context.Background())
+	s.Require().NoError(err)
+}
+
+// nextEvent wait for the next user data event of type t, skipping the other events
+func (s *futuresServerTestSuite) nextEvent(t futures.UserDataEventType) *futures.WsUserDataEvent {
+	for {
+		select {
+		case e := <-s.events:
+			if e.Event == t {
+				return e
+			}
+		case <-time.After(5 * time.Second):
+			s.FailNow("no user data event", t)
+			return nil
+		}
+	}
+}
+
+func (s *futuresServerTestSuite) positions(ctx context.Context) map[futures.PositionSideType]*futures.PositionRisk {
+	risks, err := s.client.NewGetPositionRiskService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	res := map[futures.PositionSideType]*futures.PositionRisk{}
+	for _, r := range risks {
+		res[futures.PositionSideType(r.PositionSide)] = r
+	}
+	return res
+}
+
+func (s *futuresServerTestSuite) marketOrder(side futures.SideType, quantity string) *futures.CreateOrderService {
+	return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(side).
+		Type(futures.OrderTypeMarket).Quantity(quantity)
+}
+
+func (s *futuresServerTestSuite) TestOneWayReduceOnly() {
+	ctx := context.Background()
+	_, err := s.server.AddLiquidity("BTCUSDT", futures.SideTypeSell, "30000", "0.1")
+	s.Require().NoError(err)
+
+	// there is no position to reduce
+	_, err = s.marketOrder(futures.SideTypeSell, "0.1").ReduceOnly(true).Do(ctx)
+	s.ErrorIs(err, common.ErrReduceOnlyRejected)
+
+	order, err := s.marketOrder(futures.SideTypeBuy, "0.1").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeFilled, order.Status)
+	s.Equal("30000.00000000", order.AvgPrice)
+	update := s.nextEvent(futures.UserDataEventTypeOrderTradeUpdate).OrderTradeUpdate
+	s.Equal(futures.OrderExecutionTypeNew, update.ExecutionType)
+	update = s.nextEvent(futures.UserDataEventTypeOrderTradeUpdate).OrderTradeUpdate
+	s.Equal(futures.OrderExecutionTypeTrade, update.ExecutionType)
+	s.Equal("1.50000000", update.Commission)
+	account := s.nextEvent(futures.UserDataEventTypeAccountUpdate).AccountUpdate
+	s.Equal(futures.UserDataEventReasonTypeOrder, account.Reason)
+	s.Equal("9998.50000000", account.Balances[0].Balance)
+	s.Equal("0.10000000", account.Positions[0].Amount)
+
+	// reduce-only orders can not increase or flip the position
+	_, err = s.marketOrder(futures.SideTypeBuy, "0.1").ReduceOnly(true).Do(ctx)
+	s.ErrorIs(err, common.ErrReduceOnlyRejected)
+	_, err = s.marketOrder(futures.SideTypeSell, "0.2").ReduceOnly(true).Do(ctx)
+	s.ErrorIs(err, common.ErrReduceOnlyRejected)
+
+	// a buyer at 31000 takes half of the position with a 50 USDT profit
+	_, err = s.server.AddLiquidity("BTCUSDT", futures.SideTypeBuy, "31000", "1")
+	s.Require().NoError(err)
+	order, err = s.marketOrder(futures.SideTypeSell, "0.05").ReduceOnly(true).Do(ctx)
+	s.Require().NoError(err)
+	s.True(order.ReduceOnly)
+	position := s.positions(ctx)[futures.PositionSideTypeBoth]
+	s.Equal("0.05000000", position.PositionAmt)
+	s.Equal("30000.00000000", position.EntryPrice)
+	s.Equal("cross", position.MarginType)
+
+	incomes, err := s.client.NewGetIncomeHistoryService().IncomeType("REALIZED_PNL").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(incomes, 1)
+	s.Equal("50.00000000", incomes[0].Income)
+	wallet, _ := s.server.Balance("USDT")
+	// 10000 + 50 - 1.5 - 0.775 commissions
+	s.Equal("10047.72500000", wallet)
+
+	// a one-way order larger than the position flips it
+	order, err = s.marketOrder(futures.SideTypeSell, "0.15").Do(ctx)
+	s.Require().NoError(err)
+	position = s.positions(ctx)[futures.PositionSideTypeBoth]
+	s.Equal("-0.10000000", position.PositionAmt)
+	s.Equal("31000.00000000", position.EntryPrice)
+}
+
+func (s *futuresServerTestSuite) TestHedgeMode() {
+	ctx := context.Background()
+	s.Require().NoError(s.client.NewChangePositionModeService().DualSide(true).Do(ctx))
+	mode, err := s.client.NewGetPositionModeService().Do(ctx)
+	s.Require().NoError(err)
+	s.True(mode.DualSidePosition)
+	_, err = s.server.AddLiquidity("BTCUSDT", futures.SideTypeSell, "30000", "1")
+	s.Require().NoError(err)
+	_, err = s.server.AddLiquidity("BTCUSDT", futures.SideTypeBuy, "29000", "1")
+	s.Require().NoError(err)
+
+	_, err = s.marketOrder(futures.SideTypeBuy, "0.1").Do(ctx)
+	s.Require().Error(err)
+	s.Equal(int64(-4061), common.AsAPIError(err).Code)
+	_, err = s.marketOrder(futures.SideTypeBuy, "0.1").PositionSide(futures.PositionSideTypeLong).ReduceOnly(true).Do(ctx)
+	s.Require().Error(err)
+	s.Equal(int64(-1106), common.AsAPIError(err).Code)
+
+	_, err = s.marketOrder(futures.SideTypeBuy, "0.1").PositionSide(futures.PositionSideTypeLong).Do(ctx)
+	s.Require().NoError(err)
+	_, err = s.marketOrder(futures.SideTypeSell, "0.05").PositionSide(futures.PositionSideTypeShort).Do(ctx)
+	s.Require().NoError(err)
+	positions := s.positions(ctx)
+	s.Len(positions, 2)
+	s.Equal("0.10000000", positions[futures.PositionSideTypeLong].PositionAmt)
+	s.Equal("-0.05000000", positions[futures.PositionSideTypeShort].PositionAmt)
+
+	// closing orders can not exceed the position of their side
+	_, err = s.marketOrder(futures.SideTypeSell, "0.2").PositionSide(futures.PositionSideTypeLong).Do(ctx)
+	s.ErrorIs(err, common.ErrReduceOnlyRejected)
+	_, err = s.marketOrder(futures.SideTypeSell, "0.1").PositionSide(futures.PositionSideTypeLong).Do(ctx)
+	s.Require().NoError(err)
+	risks, err := s.client.NewGetPositionRiskV3Service().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(risks, 1)
+	s.Equal("SHORT", risks[0].PositionSide)
+
+	err = s.client.NewChangePositionModeService().DualSide(false).Do(ctx)
+	s.Require().Error(err)
+	s.Equal(int64(-4068), common.AsAPIError(err).Code)
+}
+
+func (s *futuresServerTestSuite) TestIsolatedMarginAndFunding() {
+	ctx := context.Background()
+	s.Require().NoError(s.client.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(futures.MarginTypeIsolated).Do(ctx))
+	leverage, err := s.client.NewChangeLeverageService().Symbol("BTCUSDT").Leverage(5).Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(5, leverage.Leverage)
+	s.Equal(int64(5), s.nextEvent(futures.UserDataEventTypeAccountConfigUpdate).AccountConfigUpdate.Leverage)
+
+	// the margin is checked against the available balance
+	_, err = s.server.AddLiquidity("BTCUSDT", futures.SideTypeSell, "30000", "10")
+	s.Require().NoError(err)
+	_, err = s.marketOrder(futures.SideTypeBuy, "2").Do(ctx)
+	s.ErrorIs(err, common.ErrMarginNotSufficient)
+
+	_, err = s.marketOrder(futures.SideTypeBuy, "1").Do(ctx)
+	s.Require().NoError(err)
+	position := s.positions(ctx)[futures.PositionSideTypeBoth]
+	s.Equal("isolated", position.MarginType)
+	s.Equal("6000.00000000", position.IsolatedWallet)
+	err = s.client.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(futures.MarginTypeCrossed).Do(ctx)
+	s.Equal(int64(-4048), common.AsAPIError(err).Code)
+
+	// the PnL follows the mark price, close to the liquidation the margin is called
+	s.Require().NoError(s.server.SetMarkPrice("BTCUSDT", "29000"))
+	position = s.positions(ctx)[futures.PositionSideTypeBoth]
+	s.Equal("-1000.00000000", position.UnRealizedProfit)
+	s.Equal("5000.00000000", position.IsolatedMargin)
+	s.Require().NoError(s.server.SetMarkPrice("BTCUSDT", "24100"))
+	call := s.nextEvent(futures.UserDataEventTypeMarginCall)
+	s.Require().Len(call.MarginCallPositions, 1)
+	s.Equal("-5900.00000000", call.MarginCallPositions[0].UnrealizedPnL)
+	s.Equal("96.40000000", call.MarginCallPositions[0].MaintenanceMarginRequired)
+
+	// the long position pays the funding at 08:00
+	s.Require().NoError(s.server.SetMarkPrice("BTCUSDT", "30000"))
+	s.Require().NoError(s.server.SetFundingRate("BTCUSDT", "0.001"))
+	premium, err := s.client.NewPremiumIndexService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(premium, 1)
+	s.Equal("0.00100000", premium[0].LastFundingRate)
+	s.Equal(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC).UnixMilli(), premium[0].NextFundingTime)
+	s.clock.Add(time.Hour)
+	s.syncTime()
+	incomes, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSDT").IncomeType("FUNDING_FEE").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(incomes, 1)
+	s.Equal("-30.00000000", incomes[0].Income)
+	account := s.nextEvent(futures.UserDataEventTypeAccountUpdate).AccountUpdate
+	for account.Reason != futures.UserDataEventReasonTypeFundingFee {
+		account = s.nextEvent(futures.UserDataEventTypeAccountUpdate).AccountUpdate
+	}
+	s.Equal("-30.00000000", account.Balances[0].ChangeBalance)
+	s.Equal("5970.00000000", account.Positions[0].IsolatedWallet)
+}
+
+func (s *futuresServerTestSuite) TestModifyAndBatchOrders() {
+	ctx := context.Background()
+	limit := func(side futures.SideType, price, quantity string) *futures.CreateOrderService {
+		return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(side).Type(futures.OrderTypeLimit).
+			TimeInForce(futures.TimeInForceTypeGTC).Price(price).Quantity(quantity)
+	}
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*futures.CreateOrderService{
+		limit(futures.SideTypeBuy, "29000", "0.1"),
+		limit(futures.SideTypeBuy, "29000.001", "0.1"),
+		limit(futures.SideTypeSell, "31000", "0.1"),
+	}).Do(ctx)
+	s.Require().NoError(err)
+	s.Len(res.Orders, 2)
+	s.Nil(res.Errors[0])
+	s.ErrorIs(res.Errors[1], common.ErrInvalidMessage)
+
+	buy := res.Orders[0]
+	modified, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(buy.OrderID).
+		Side(futures.SideTypeBuy).Price("29500").Quantity("0.2").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal("29500.00000000", modified.Price)
+	s.Equal("0.20000000", modified.OriginalQuantity)
+	depth, err := s.client.NewDepthService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.Bid{Price: "29500.00000000", Quantity: "0.20000000"}, depth.Bids[0])
+
+	// a seller fills half of the modified order
+	_, err = s.server.AddLiquidity("BTCUSDT", futures.SideTypeSell, "29500", "0.1")
+	s.Require().NoError(err)
+	got, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(buy.OrderID).Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypePartiallyFilled, got.Status)
+
+	canceled, err := s.client.NewCancelMultipleOrdersService().Symbol("BTCUSDT").
+		OrderIDList([]int64{buy.OrderID, res.Orders[1].OrderID, 999}).Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(canceled, 3)
+	s.Equal(futures.OrderStatusTypeCanceled, canceled[0].Status)
+	s.Equal(futures.OrderStatusTypeCanceled, canceled[1].Status)
+	s.Empty(canceled[2].Symbol)
+	open, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Empty(open)
+	trades, err := s.client.NewListAccountTradeService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 1)
+	s.True(trades[0].Maker)
+	s.Equal("0.59000000", trades[0].Commission)
+}
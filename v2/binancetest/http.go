@@ -0,0 +1,379 @@
+package binancetest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// defaultRecvWindow is the recvWindow of signed requests sent without one, in milliseconds
+const defaultRecvWindow = 5000
+
+// apiError init an error answered with status and the Binance error payload
+func apiError(status int, code int64, format string, args ...interface{}) *common.APIError {
+	return &common.APIError{Code: code, Message: fmt.Sprintf(format, args...), StatusCode: status}
+}
+
+func errMandatoryParam(name string) *common.APIError {
+	return apiError(http.StatusBadRequest, -1102, "Mandatory parameter '%s' was not sent, was empty/null, or malformed.", name)
+}
+
+func errInvalidParam(name string) *common.APIError {
+	return apiError(http.StatusBadRequest, -1100, "Illegal characters found in parameter '%s'.", name)
+}
+
+func errFilter(filter string) *common.APIError {
+	return apiError(http.StatusBadRequest, common.ErrCodeInvalidMessage, "Filter failure: %s", filter)
+}
+
+var (
+	errBadSymbol           = apiError(http.StatusBadRequest, common.ErrCodeBadSymbol, "Invalid symbol.")
+	errRejectedAPIKey      = apiError(http.StatusUnauthorized, common.ErrCodeRejectedAPIKey, "Invalid API-key, IP, or permissions for action.")
+	errInvalidSignature    = apiError(http.StatusBadRequest, common.ErrCodeInvalidSignature, "Signature for this request is not valid.")
+	errInvalidTimestamp    = apiError(http.StatusBadRequest, common.ErrCodeInvalidTimestamp, "Timestamp for this request is outside of the recvWindow.")
+	errInsufficientBalance = apiError(http.StatusBadRequest, common.ErrCodeNewOrderRejected, "Account has insufficient balance for requested action.")
+	errUnknownOrder        = apiError(http.StatusBadRequest, common.ErrCodeCancelRejected, "Unknown order sent.")
+	errNoSuchOrder         = apiError(http.StatusBadRequest, common.ErrCodeNoSuchOrder, "Order does not exist.")
+	errInvalidListenKey    = apiError(http.StatusBadRequest, -1125, "This listenKey does not exist.")
+)
+
+// request is a request received by a fake exchange
+type request struct {
+	*http.Request
+	// params are the parameters of both the query string and the form body
+	params url.Values
+}
+
+func (r *request) param(name string) string {
+	return r.params.Get(name)
+}
+
+func (r *request) requireParam(name string) (string, error) {
+	v := r.params.Get(name)
+	if v == "" {
+		return "", errMandatoryParam(name)
+	}
+	return v, nil
+}
+
+// amountParam return the decimal parameter, ok is false when it was not sent
+func (r *request) amountParam(name string) (a amount, ok bool, err error) {
+	v := r.params.Get(name)
+	if v == "" {
+		return 0, false, nil
+	}
+	a, err = parseAmount(v)
+	if err != nil || a < 0 {
+		return 0, false, errInvalidParam(name)
+	}
+	return a, true, nil
+}
+
+func (r *request) int64Param(name string, defaultValue int64) (int64, error) {
+	v := r.params.Get(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, errInvalidParam(name)
+	}
+	return i, nil
+}
+
+type secType int
+
+const (
+	secTypeNone secType = iota
+	secTypeAPIKey
+	secTypeSigned
+)
+
+type handlerFunc func(r *request) (interface{}, error)
+
+type route struct {
+	secType secType
+	handler handlerFunc
+}
+
+// api is the REST layer shared by the fake exchanges: routing, API key and signature checks,
+// error payloads and used weight headers
+type api struct {
+	apiKey    string
+	secretKey string
+	now       func() time.Time
+	routes    map[string]route
+
+	mu         sync.Mutex
+	minute     int64
+	usedWeight int64
+}
+
+func newAPI(apiKey, secretKey string, now func() time.Time) *api {
+	if now == nil {
+		now = time.Now
+	}
+	return &api{
+		apiKey:    apiKey,
+		secretKey: secretKey,
+		now:       now,
+		routes:    map[string]route{},
+	}
+}
+
+func (a *api) handle(method, path string, sec secType, h handlerFunc) {
+	a.routes[method+" "+path] = route{secType: sec, handler: h}
+}
+
+func (a *api) timestamp() int64 {
+	return a.now().UnixNano() / int64(time.Millisecond)
+}
+
+func (a *api) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, apiError(http.StatusBadRequest, common.ErrCodeUnknown, "%v", err))
+		return
+	}
+	rt, ok := a.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		writeError(w, apiError(http.StatusNotFound, common.ErrCodeUnknown, "Unknown endpoint %s %s.", r.Method, r.URL.Path))
+		return
+	}
+	w.Header().Set("X-Mbx-Used-Weight-1m", strconv.FormatInt(a.addWeight(), 10))
+	req := &request{Request: r, params: r.URL.Query()}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, errInvalidParam("body"))
+		return
+	}
+	for k, v := range form {
+		req.params[k] = append(req.params[k], v...)
+	}
+	if rt.secType >= secTypeAPIKey && a.apiKey != "" && r.Header.Get("X-MBX-APIKEY") != a.apiKey {
+		writeError(w, errRejectedAPIKey)
+		return
+	}
+	if rt.secType == secTypeSigned {
+		if err := a.verify(req, string(body)); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	res, err := rt.handler(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// addWeight count a request in the weight of the current minute
+func (a *api) addWeight() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	minute := a.now().Unix() / 60
+	if minute != a.minute {
+		a.minute = minute
+		a.usedWeight = 0
+	}
+	a.usedWeight++
+	return a.usedWeight
+}
+
+// verify check the timestamp and the HMAC signature of a signed request,
+// the signature is the last parameter of the query string
+func (a *api) verify(r *request, body string) error {
+	ts, err := r.int64Param("timestamp", 0)
+	if err != nil || ts == 0 {
+		return errMandatoryParam("timestamp")
+	}
+	recvWindow, err := r.int64Param("recvWindow", defaultRecvWindow)
+	if err != nil {
+		return err
+	}
+	now := a.timestamp()
+	if ts < now-recvWindow || ts > now+1000 {
+		return errInvalidTimestamp
+	}
+	if a.secretKey == "" {
+		return nil
+	}
+	signature := r.param("signature")
+	if signature == "" {
+		return errMandatoryParam("signature")
+	}
+	parts := strings.Split(r.URL.RawQuery, "&")
+	query := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if !strings.HasPrefix(p, "signature=") {
+			query = append(query, p)
+		}
+	}
+	expected, err := common.Hmac(a.secretKey, strings.Join(query, "&")+body)
+	if err != nil || *expected != signature {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr := common.AsAPIError(err)
+	if apiErr == nil {
+		apiErr = apiError(http.StatusInternalServerError, common.ErrCodeUnknown, "%v", err)
+	}
+	status := apiErr.StatusCode
+	if status == 0 {
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, apiErr)
+}
+
+// userStreams fan out the user data events to the websocket connections of the listen keys
+type userStreams struct {
+	mu       sync.Mutex
+	upgrader websocket.Upgrader
+	keys     map[string]map[*streamConn]struct{}
+}
+
+type streamConn struct {
+	conn *websocket.Conn
+	send chan []byte
+}
+
+func newUserStreams() *userStreams {
+	return &userStreams{keys: map[string]map[*streamConn]struct{}{}}
+}
+
+// create return the listen key of the account, a new one when there is none
+func (s *userStreams) create() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.keys {
+		return key
+	}
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	key := hex.EncodeToString(b)
+	s.keys[key] = map[*streamConn]struct{}{}
+	return key
+}
+
+func (s *userStreams) exists(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.keys[key]
+	return ok
+}
+
+// remove close the listen key and its connections
+func (s *userStreams) remove(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conns, ok := s.keys[key]
+	if !ok {
+		return false
+	}
+	for c := range conns {
+		close(c.send)
+	}
+	delete(s.keys, key)
+	return true
+}
+
+// close remove all the listen keys
+func (s *userStreams) close() {
+	s.mu.Lock()
+	keys := make([]string, 0, len(s.keys))
+	for key := range s.keys {
+		keys = append(keys, key)
+	}
+	s.mu.Unlock()
+	for _, key := range keys {
+		s.remove(key)
+	}
+}
+
+// push send the event to every connection, connections which can not keep up are closed
+func (s *userStreams) push(event interface{}) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conns := range s.keys {
+		for c := range conns {
+			select {
+			case c.send <- data:
+			default:
+				close(c.send)
+				delete(conns, c)
+			}
+		}
+	}
+}
+
+// serve upgrade the connection of a listen key and write the events until the listen key is removed
+func (s *userStreams) serve(w http.ResponseWriter, r *http.Request, key string) {
+	if !s.exists(key) {
+		writeError(w, errInvalidListenKey)
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &streamConn{conn: conn, send: make(chan []byte, 1024)}
+	s.mu.Lock()
+	conns, ok := s.keys[key]
+	if ok {
+		conns[c] = struct{}{}
+	}
+	s.mu.Unlock()
+	if !ok {
+		conn.Close()
+		return
+	}
+	go func() {
+		// read until the client goes away to process the control frames
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				s.mu.Lock()
+				if _, ok := s.keys[key][c]; ok {
+					delete(s.keys[key], c)
+					close(c.send)
+				}
+				s.mu.Unlock()
+				return
+			}
+		}
+	}()
+	go func() {
+		defer conn.Close()
+		for data := range c.send {
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}()
+}
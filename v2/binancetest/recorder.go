@@ -0,0 +1,281 @@
+package binancetest
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamRecord define a websocket message written by a Recorder, one JSON object per line
+type StreamRecord struct {
+	Time time.Time `json:"time"`
+	// Endpoint is the path and the query of the stream, the host is not recorded
+	Endpoint string          `json:"endpoint"`
+	Message  json.RawMessage `json:"message"`
+}
+
+// Recorder write the raw messages of the websocket streams to a JSONL file of StreamRecord,
+// compressed with gzip when the path ends with .gz. Install it on any environment with
+//
+//	env.WsMiddlewares = append(env.WsMiddlewares, recorder.WsMiddleware())
+//
+// and Close it to flush the file.
+type Recorder struct {
+	mu     sync.Mutex
+	file   *os.File
+	gz     *gzip.Writer
+	buf    *bufio.Writer
+	enc    *json.Encoder
+	err    error
+	closed bool
+	now    func() time.Time
+}
+
+// NewRecorder create the file at path and return a recorder writing to it
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{file: f, now: time.Now}
+	var w io.Writer = f
+	if strings.HasSuffix(path, ".gz") {
+		r.gz = gzip.NewWriter(f)
+		w = r.gz
+	}
+	r.buf = bufio.NewWriter(w)
+	r.enc = json.NewEncoder(r.buf)
+	return r, nil
+}
+
+// WsMiddleware return the middleware recording the messages of the streams before their handler,
+// see Environment.WsMiddlewares
+func (r *Recorder) WsMiddleware() common.WsMiddleware {
+	return func(next common.WsServeFunc) common.WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			key, err := streamKey(endpoint)
+			if err != nil {
+				return nil, nil, err
+			}
+			return next(endpoint, func(message []byte) {
+				r.record(key, message)
+				handler(message)
+			}, errHandler)
+		}
+	}
+}
+
+// record write a message, the first error is kept for Close
+func (r *Recorder) record(endpoint string, message []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed || r.err != nil {
+		return
+	}
+	err := r.enc.Encode(&StreamRecord{Time: r.now(), Endpoint: endpoint, Message: message})
+	if err != nil {
+		r.err = fmt.Errorf("binancetest: record %s: %w", endpoint, err)
+	}
+}
+
+// Close flush and close the file, the messages received afterwards are not recorded.
+// It returns the first error of the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return r.err
+	}
+	r.closed = true
+	errs := []error{r.err, r.buf.Flush()}
+	if r.gz != nil {
+		errs = append(errs, r.gz.Close())
+	}
+	errs = append(errs, r.file.Close())
+	for _, err := range errs {
+		if err != nil {
+			r.err = err
+			break
+		}
+	}
+	return r.err
+}
+
+// Replayer send the messages of a Recorder file to the handlers of the streams, so that a backtest
+// parses them exactly as the production does. Install it on any environment with
+//
+//	env.WsMiddlewares = []common.WsMiddleware{replayer.WsMiddleware()}
+//
+// open the streams with the Ws*Serve functions, then call Run. No connection is opened, the messages
+// are sent to the streams opened on the same endpoint, whatever the host.
+type Replayer struct {
+	// Speed is the factor applied to the recorded pace: 1 replays at the original speed, 10 ten times
+	// faster, 0 as fast as possible
+	Speed float64
+
+	path string
+	mu   sync.Mutex
+	// streams are the open streams by endpoint
+	streams map[string][]*replayStream
+	ended   bool
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+type replayStream struct {
+	handler func(message []byte)
+	doneC   chan struct{}
+	once    sync.Once
+}
+
+func (s *replayStream) end() {
+	s.once.Do(func() {
+		close(s.doneC)
+	})
+}
+
+// NewReplayer return a replayer of the file at path, compressed with gzip or not
+func NewReplayer(path string) (*Replayer, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return &Replayer{
+		path:    path,
+		streams: map[string][]*replayStream{},
+		sleep:   sleepContext,
+	}, nil
+}
+
+// WsMiddleware return the middleware opening the streams on the replayer, see Environment.WsMiddlewares
+func (r *Replayer) WsMiddleware() common.WsMiddleware {
+	return func(next common.WsServeFunc) common.WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			key, err := streamKey(endpoint)
+			if err != nil {
+				return nil, nil, err
+			}
+			s := &replayStream{handler: handler, doneC: make(chan struct{})}
+			stopC := make(chan struct{})
+			r.mu.Lock()
+			if r.ended {
+				s.end()
+			} else {
+				r.streams[key] = append(r.streams[key], s)
+			}
+			r.mu.Unlock()
+			go func() {
+				select {
+				case <-stopC:
+				case <-s.doneC:
+					return
+				}
+				r.remove(key, s)
+				s.end()
+			}()
+			return s.doneC, stopC, nil
+		}
+	}
+}
+
+func (r *Replayer) remove(key string, s *replayStream) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	streams := r.streams[key]
+	for i := range streams {
+		if streams[i] == s {
+			r.streams[key] = append(streams[:i:i], streams[i+1:]...)
+			break
+		}
+	}
+}
+
+// Run replay the file in order, the handlers are called by the calling goroutine. The doneC of the
+// streams are closed once the file was replayed or ctx is done.
+func (r *Replayer) Run(ctx context.Context) error {
+	defer r.end()
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	in, err := decompress(bufio.NewReader(f))
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(in)
+	var start, first time.Time
+	for {
+		var record StreamRecord
+		if err := dec.Decode(&record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("binancetest: invalid record in %s: %w", r.path, err)
+		}
+		if r.Speed > 0 {
+			if start.IsZero() {
+				start, first = time.Now(), record.Time
+			}
+			at := start.Add(time.Duration(float64(record.Time.Sub(first)) / r.Speed))
+			if err := r.sleep(ctx, time.Until(at)); err != nil {
+				return err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		streams := r.streams[record.Endpoint]
+		r.mu.Unlock()
+		for _, s := range streams {
+			s.handler(record.Message)
+		}
+	}
+}
+
+// end close the doneC of the open streams and of the streams opened afterwards
+func (r *Replayer) end() {
+	r.mu.Lock()
+	streams := r.streams
+	r.streams = map[string][]*replayStream{}
+	r.ended = true
+	r.mu.Unlock()
+	for _, list := range streams {
+		for _, s := range list {
+			s.end()
+		}
+	}
+}
+
+// decompress return a reader of the gzip stream of r, or r when it is not compressed
+func decompress(r *bufio.Reader) (io.Reader, error) {
+	magic, err := r.Peek(2)
+	if err == io.EOF || (err == nil && !bytes.Equal(magic, []byte{0x1f, 0x8b})) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gzip.NewReader(r)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
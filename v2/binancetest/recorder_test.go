@@ -0,0 +1,161 @@
+package binancetest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// feedMiddleware serve the given messages by endpoint path instead of connecting
+func feedMiddleware(messages map[string][]string) common.WsMiddleware {
+	return func(next common.WsServeFunc) common.WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			key, err := streamKey(endpoint)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, m := range messages[key] {
+				handler([]byte(m))
+			}
+			doneC, stopC := make(chan struct{}), make(chan struct{})
+			go func() {
+				<-stopC
+				close(doneC)
+			}()
+			return doneC, stopC, nil
+		}
+	}
+}
+
+type marketEvents struct {
+	depths     []*binance.WsDepthEvent
+	aggTrades  []*binance.WsAggTradeEvent
+	markPrices []*futures.WsMarkPriceEvent
+}
+
+// serveMarket open the depth, aggTrade and mark price streams with middlewares
+func serveMarket(t *testing.T, events *marketEvents, middlewares ...common.WsMiddleware) []chan struct{} {
+	env := binance.MainnetEnvironment()
+	env.WsMiddlewares = middlewares
+	futuresEnv := futures.MainnetEnvironment()
+	futuresEnv.WsMiddlewares = middlewares
+	errHandler := func(err error) { t.Error(err) }
+
+	var doneCs []chan struct{}
+	doneC, _, err := env.WsDepthServe("BTCUSDT", func(event *binance.WsDepthEvent) {
+		events.depths = append(events.depths, event)
+	}, errHandler)
+	require.NoError(t, err)
+	doneCs = append(doneCs, doneC)
+	doneC, _, err = env.WsAggTradeServe("BTCUSDT", func(event *binance.WsAggTradeEvent) {
+		events.aggTrades = append(events.aggTrades, event)
+	}, errHandler)
+	require.NoError(t, err)
+	doneCs = append(doneCs, doneC)
+	doneC, _, err = futuresEnv.WsMarkPriceServe("BTCUSDT", func(event *futures.WsMarkPriceEvent) {
+		events.markPrices = append(events.markPrices, event)
+	}, errHandler)
+	require.NoError(t, err)
+	return append(doneCs, doneC)
+}
+
+func TestRecorderReplayer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "market.jsonl.gz")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+	feed := feedMiddleware(map[string][]string{
+		"/ws/btcusdt@depth":     {`{"e":"depthUpdate","E":1,"s":"BTCUSDT","U":1,"u":2,"b":[["30000.0","1.5"]],"a":[["30001.0","0"]]}`},
+		"/ws/btcusdt@aggTrade":  {`{"e":"aggTrade","E":2,"s":"BTCUSDT","a":7,"p":"30000.5","q":"0.1","f":1,"l":2,"T":2,"m":true}`, `{"e":"aggTrade","E":3,"s":"BTCUSDT","a":8,"p":"30000.6","q":"0.2","f":3,"l":3,"T":3,"m":false}`},
+		"/ws/btcusdt@markPrice": {`{"e":"markPriceUpdate","E":4,"s":"BTCUSDT","p":"30010.1","i":"30009.9","P":"30012.0","r":"0.0001","T":5}`},
+	})
+	var recorded marketEvents
+	serveMarket(t, &recorded, recorder.WsMiddleware(), feed)
+	require.NoError(t, recorder.Close())
+	require.Len(t, recorded.aggTrades, 2)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x1f, 0x8b}, b[:2])
+
+	replayer, err := NewReplayer(path)
+	require.NoError(t, err)
+	var replayed marketEvents
+	doneCs := serveMarket(t, &replayed, replayer.WsMiddleware())
+	require.NoError(t, replayer.Run(context.Background()))
+	for _, doneC := range doneCs {
+		<-doneC
+	}
+	assert.Equal(t, recorded, replayed)
+}
+
+func TestReplayerSpeed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trades.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"time":"2024-01-01T00:00:00Z","endpoint":"/ws/btcusdt@trade","message":{"t":1}}
+{"time":"2024-01-01T00:00:01Z","endpoint":"/ws/ethusdt@trade","message":{"t":2}}
+{"time":"2024-01-01T00:00:03Z","endpoint":"/ws/btcusdt@trade","message":{"t":3}}
+`), 0o644))
+
+	for _, tt := range []struct {
+		speed float64
+		want  []time.Duration
+	}{
+		{speed: 0},
+		{speed: 1, want: []time.Duration{0, time.Second, 3 * time.Second}},
+		{speed: 10, want: []time.Duration{0, 100 * time.Millisecond, 300 * time.Millisecond}},
+	} {
+		replayer, err := NewReplayer(path)
+		require.NoError(t, err)
+		replayer.Speed = tt.speed
+		// the replay does not wait, the sleeps are only recorded
+		var sleeps []time.Duration
+		replayer.sleep = func(ctx context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		}
+		var trades []int64
+		env := binance.MainnetEnvironment()
+		env.WsMiddlewares = []common.WsMiddleware{replayer.WsMiddleware()}
+		_, _, err = env.WsTradeServe("BTCUSDT", func(event *binance.WsTradeEvent) {
+			trades = append(trades, event.TradeID)
+		}, func(err error) {})
+		require.NoError(t, err)
+
+		require.NoError(t, replayer.Run(context.Background()))
+		assert.Equal(t, []int64{1, 3}, trades)
+		require.Len(t, sleeps, len(tt.want))
+		for i, d := range tt.want {
+			assert.InDelta(t, float64(d), float64(sleeps[i]), float64(50*time.Millisecond))
+		}
+	}
+}
+
+func TestReplayerCanceled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trades.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"time":"2024-01-01T00:00:00Z","endpoint":"/ws/btcusdt@trade","message":{"t":1}}
+{"time":"2024-01-01T01:00:00Z","endpoint":"/ws/btcusdt@trade","message":{"t":2}}
+`), 0o644))
+	replayer, err := NewReplayer(path)
+	require.NoError(t, err)
+	replayer.Speed = 1
+	env := binance.MainnetEnvironment()
+	env.WsMiddlewares = []common.WsMiddleware{replayer.WsMiddleware()}
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC, _, err := env.WsTradeServe("BTCUSDT", func(event *binance.WsTradeEvent) {
+		cancel()
+	}, func(err error) {})
+	require.NoError(t, err)
+
+	assert.Equal(t, context.Canceled, replayer.Run(ctx))
+	<-doneC
+}
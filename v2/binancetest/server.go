@@ -0,0 +1,1035 @@
+// Package binancetest provides in-process fake Binance exchanges for integration tests.
+// They implement the REST endpoints wrapped by this library on top of httptest servers,
+// match orders by price-time priority and push the user data events over websocket.
+package binancetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// SymbolConfig define a symbol of the fake exchange and its filters, empty filters are not checked
+type SymbolConfig struct {
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+
+	// PRICE_FILTER
+	TickSize string
+	MinPrice string
+	MaxPrice string
+	// LOT_SIZE
+	StepSize string
+	MinQty   string
+	MaxQty   string
+	// NOTIONAL
+	MinNotional string
+}
+
+// Config define the account and the market of a fake spot exchange
+type Config struct {
+	// APIKey is the expected X-MBX-APIKEY header, any key is accepted when empty
+	APIKey string
+	// SecretKey is the HMAC secret signed requests are verified with, signatures are not verified when empty
+	SecretKey string
+	// Symbols are the traded symbols, BTCUSDT is traded when empty
+	Symbols []SymbolConfig
+	// Balances are the initial free balances of the account by asset
+	Balances map[string]string
+	// MakerCommission and TakerCommission are the commission rates, e.g. "0.001",
+	// commissions are paid with the received asset
+	MakerCommission string
+	TakerCommission string
+	// Now is the clock of the exchange, time.Now when nil
+	Now func() time.Time
+}
+
+// DefaultSymbol is the symbol traded when Config.Symbols is empty
+var DefaultSymbol = SymbolConfig{
+	Symbol:      "BTCUSDT",
+	BaseAsset:   "BTC",
+	QuoteAsset:  "USDT",
+	TickSize:    "0.01",
+	MinPrice:    "0.01",
+	MaxPrice:    "1000000",
+	StepSize:    "0.00001",
+	MinQty:      "0.00001",
+	MaxQty:      "9000",
+	MinNotional: "5",
+}
+
+type symbol struct {
+	SymbolConfig
+	tickSize    amount
+	minPrice    amount
+	maxPrice    amount
+	stepSize    amount
+	minQty      amount
+	maxQty      amount
+	minNotional amount
+	book        book
+}
+
+func newSymbol(c SymbolConfig) *symbol {
+	return &symbol{
+		SymbolConfig: c,
+		tickSize:     mustAmount(c.TickSize),
+		minPrice:     mustAmount(c.MinPrice),
+		maxPrice:     mustAmount(c.MaxPrice),
+		stepSize:     mustAmount(c.StepSize),
+		minQty:       mustAmount(c.MinQty),
+		maxQty:       mustAmount(c.MaxQty),
+		minNotional:  mustAmount(c.MinNotional),
+	}
+}
+
+type balance struct {
+	free   amount
+	locked amount
+}
+
+// Server is a fake spot exchange serving a single account
+type Server struct {
+	// URL is the base URL of the REST API
+	URL string
+	// WsURL is the base URL of the websocket streams
+	WsURL string
+
+	cfg     Config
+	server  *httptest.Server
+	api     *api
+	streams *userStreams
+
+	mu              sync.Mutex
+	symbols         map[string]*symbol
+	balances        map[string]*balance
+	makerCommission amount
+	takerCommission amount
+	orders          []*order // orders of the account by id
+	trades          []*binance.TradeV3
+	nextOrderID     int64
+	nextTradeID     int64
+	updateID        int64
+}
+
+// NewServer start a fake spot exchange, it panics on invalid numbers in cfg
+func NewServer(cfg Config) *Server {
+	if len(cfg.Symbols) == 0 {
+		cfg.Symbols = []SymbolConfig{DefaultSymbol}
+	}
+	s := &Server{
+		cfg:             cfg,
+		api:             newAPI(cfg.APIKey, cfg.SecretKey, cfg.Now),
+		streams:         newUserStreams(),
+		symbols:         map[string]*symbol{},
+		balances:        map[string]*balance{},
+		makerCommission: mustAmount(cfg.MakerCommission),
+		takerCommission: mustAmount(cfg.TakerCommission),
+	}
+	for _, c := range cfg.Symbols {
+		s.symbols[c.Symbol] = newSymbol(c)
+	}
+	for asset, free := range cfg.Balances {
+		s.balance(asset).free = mustAmount(free)
+	}
+
+	s.api.handle(http.MethodGet, "/api/v3/ping", secTypeNone, func(r *request) (interface{}, error) {
+		return struct{}{}, nil
+	})
+	s.api.handle(http.MethodGet, "/api/v3/time", secTypeNone, func(r *request) (interface{}, error) {
+		return map[string]int64{"serverTime": s.api.timestamp()}, nil
+	})
+	s.api.handle(http.MethodGet, "/api/v3/exchangeInfo", secTypeNone, s.exchangeInfo)
+	s.api.handle(http.MethodGet, "/api/v3/depth", secTypeNone, s.depth)
+	s.api.handle(http.MethodPost, "/api/v3/order", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.createOrder(r, false)
+	})
+	s.api.handle(http.MethodPost, "/api/v3/order/test", secTypeSigned, func(r *request) (interface{}, error) {
+		return s.createOrder(r, true)
+	})
+	s.api.handle(http.MethodGet, "/api/v3/order", secTypeSigned, s.getOrder)
+	s.api.handle(http.MethodDelete, "/api/v3/order", secTypeSigned, s.cancelOrder)
+	s.api.handle(http.MethodGet, "/api/v3/openOrders", secTypeSigned, s.openOrders)
+	s.api.handle(http.MethodDelete, "/api/v3/openOrders", secTypeSigned, s.cancelOpenOrders)
+	s.api.handle(http.MethodGet, "/api/v3/allOrders", secTypeSigned, s.allOrders)
+	s.api.handle(http.MethodGet, "/api/v3/account", secTypeSigned, s.account)
+	s.api.handle(http.MethodGet, "/api/v3/myTrades", secTypeSigned, s.myTrades)
+	s.api.handle(http.MethodPost, "/api/v3/userDataStream", secTypeAPIKey, func(r *request) (interface{}, error) {
+		return map[string]string{"listenKey": s.streams.create()}, nil
+	})
+	s.api.handle(http.MethodPut, "/api/v3/userDataStream", secTypeAPIKey, func(r *request) (interface{}, error) {
+		if !s.streams.exists(r.param("listenKey")) {
+			return nil, errInvalidListenKey
+		}
+		return struct{}{}, nil
+	})
+	s.api.handle(http.MethodDelete, "/api/v3/userDataStream", secTypeAPIKey, func(r *request) (interface{}, error) {
+		if !s.streams.remove(r.param("listenKey")) {
+			return nil, errInvalidListenKey
+		}
+		return struct{}{}, nil
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws/", func(w http.ResponseWriter, r *http.Request) {
+		s.streams.serve(w, r, strings.TrimPrefix(r.URL.Path, "/ws/"))
+	})
+	mux.Handle("/", s.api)
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+	s.WsURL = "ws" + strings.TrimPrefix(s.server.URL, "http") + "/ws"
+	return s
+}
+
+// Close close the user data streams and shut down the server
+func (s *Server) Close() {
+	s.streams.close()
+	s.server.Close()
+}
+
+// Environment return an environment pointing to the server
+func (s *Server) Environment() *binance.Environment {
+	e := binance.MainnetEnvironment()
+	e.APIURL = s.URL
+	e.WsURL = s.WsURL
+	e.CombinedWsURL = s.WsURL
+	e.WsAPIURL = s.WsURL
+	return e
+}
+
+// NewClient init a client of the account of the server
+func (s *Server) NewClient() *binance.Client {
+	return binance.NewClient(s.cfg.APIKey, s.cfg.SecretKey, s.Environment())
+}
+
+// SetBalance set the free balance of an asset and push the account update
+func (s *Server) SetBalance(asset, free string) error {
+	a, err := parseAmount(free)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balance(asset).free = a
+	s.pushAccountPosition(map[string]bool{asset: true})
+	return nil
+}
+
+// Balance return the free and locked balances of an asset
+func (s *Server) Balance(asset string) (free, locked string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.balance(asset)
+	return b.free.String(), b.locked.String()
+}
+
+// AddLiquidity add a GTC limit order of another trader, it matches the resting orders of the account first.
+// It is used to give the account a counterparty.
+func (s *Server) AddLiquidity(symbol string, side binance.SideType, price, quantity string) (orderID int64, err error) {
+	p, err := parseAmount(price)
+	if err != nil {
+		return 0, err
+	}
+	q, err := parseAmount(quantity)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, ok := s.symbols[symbol]
+	if !ok {
+		return 0, errBadSymbol
+	}
+	o := &order{
+		symbol:      symbol,
+		side:        side,
+		orderType:   binance.OrderTypeLimit,
+		timeInForce: binance.TimeInForceTypeGTC,
+		price:       p,
+		origQty:     q,
+		liquidity:   true,
+	}
+	s.place(sym, o, sym.book.match(side, p, q, 0, sym.stepSize), true)
+	return o.id, nil
+}
+
+func (s *Server) balance(asset string) *balance {
+	b, ok := s.balances[asset]
+	if !ok {
+		b = &balance{}
+		s.balances[asset] = b
+	}
+	return b
+}
+
+func (s *Server) symbol(r *request) (*symbol, error) {
+	name, err := r.requireParam("symbol")
+	if err != nil {
+		return nil, err
+	}
+	sym, ok := s.symbols[name]
+	if !ok {
+		return nil, errBadSymbol
+	}
+	return sym, nil
+}
+
+func (s *Server) exchangeInfo(r *request) (interface{}, error) {
+	names := map[string]bool{}
+	if name := r.param("symbol"); name != "" {
+		names[name] = true
+	}
+	if v := r.param("symbols"); v != "" {
+		var list []string
+		if err := json.Unmarshal([]byte(v), &list); err != nil {
+			return nil, errInvalidParam("symbols")
+		}
+		for _, name := range list {
+			names[name] = true
+		}
+	}
+	info := &binance.ExchangeInfo{
+		Timezone:   "UTC",
+		ServerTime: s.api.timestamp(),
+		RateLimits: []binance.RateLimit{
+			{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+			{RateLimitType: "ORDERS", Interval: "SECOND", IntervalNum: 10, Limit: 100},
+		},
+		ExchangeFilters: []interface{}{},
+		Symbols:         []binance.Symbol{},
+	}
+	for name := range names {
+		if _, ok := s.symbols[name]; !ok {
+			return nil, errBadSymbol
+		}
+	}
+	for _, c := range s.cfg.Symbols {
+		if len(names) > 0 && !names[c.Symbol] {
+			continue
+		}
+		filters := []map[string]interface{}{}
+		if c.TickSize != "" || c.MinPrice != "" || c.MaxPrice != "" {
+			filters = append(filters, map[string]interface{}{
+				"filterType": "PRICE_FILTER",
+				"minPrice":   mustAmount(c.MinPrice).String(),
+				"maxPrice":   mustAmount(c.MaxPrice).String(),
+				"tickSize":   mustAmount(c.TickSize).String(),
+			})
+		}
+		if c.StepSize != "" || c.MinQty != "" || c.MaxQty != "" {
+			filters = append(filters, map[string]interface{}{
+				"filterType": "LOT_SIZE",
+				"minQty":     mustAmount(c.MinQty).String(),
+				"maxQty":     mustAmount(c.MaxQty).String(),
+				"stepSize":   mustAmount(c.StepSize).String(),
+			})
+		}
+		if c.MinNotional != "" {
+			filters = append(filters, map[string]interface{}{
+				"filterType":       "NOTIONAL",
+				"minNotional":      mustAmount(c.MinNotional).String(),
+				"applyMinToMarket": true,
+				"maxNotional":      "9000000.00000000",
+				"applyMaxToMarket": false,
+				"avgPriceMins":     5,
+			})
+		}
+		info.Symbols = append(info.Symbols, binance.Symbol{
+			Symbol:                     c.Symbol,
+			Status:                     "TRADING",
+			BaseAsset:                  c.BaseAsset,
+			BaseAssetPrecision:         amountDecimals,
+			QuoteAsset:                 c.QuoteAsset,
+			QuotePrecision:             amountDecimals,
+			QuoteAssetPrecision:        amountDecimals,
+			BaseCommissionPrecision:    amountDecimals,
+			QuoteCommissionPrecision:   amountDecimals,
+			OrderTypes:                 []string{string(binance.OrderTypeLimit), string(binance.OrderTypeLimitMaker), string(binance.OrderTypeMarket)},
+			QuoteOrderQtyMarketAllowed: true,
+			IsSpotTradingAllowed:       true,
+			Filters:                    filters,
+			Permissions:                []string{"SPOT"},
+		})
+	}
+	return info, nil
+}
+
+func (s *Server) depth(r *request) (interface{}, error) {
+	limit, err := r.int64Param("limit", 100)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	levels := func(side binance.SideType) [][2]string {
+		res := [][2]string{}
+		for _, l := range sym.book.levels(side, int(limit)) {
+			res = append(res, [2]string{l.price.String(), l.qty.String()})
+		}
+		return res
+	}
+	return map[string]interface{}{
+		"lastUpdateId": s.updateID,
+		"bids":         levels(binance.SideTypeBuy),
+		"asks":         levels(binance.SideTypeSell),
+	}, nil
+}
+
+// checkFilters apply the PRICE_FILTER, LOT_SIZE and NOTIONAL filters, price is 0 for market orders
+func (sym *symbol) checkFilters(price, qty amount) error {
+	if price > 0 {
+		if (sym.minPrice > 0 && price < sym.minPrice) || (sym.maxPrice > 0 && price > sym.maxPrice) ||
+			(sym.tickSize > 0 && (price-sym.minPrice)%sym.tickSize != 0) {
+			return errFilter("PRICE_FILTER")
+		}
+	}
+	if qty > 0 {
+		if (sym.minQty > 0 && qty < sym.minQty) || (sym.maxQty > 0 && qty > sym.maxQty) ||
+			(sym.stepSize > 0 && (qty-sym.minQty)%sym.stepSize != 0) {
+			return errFilter("LOT_SIZE")
+		}
+	}
+	if price > 0 && qty > 0 && price.mul(qty) < sym.minNotional {
+		return errFilter("NOTIONAL")
+	}
+	return nil
+}
+
+func (s *Server) createOrder(r *request, test bool) (interface{}, error) {
+	side := binance.SideType(r.param("side"))
+	if side != binance.SideTypeBuy && side != binance.SideTypeSell {
+		return nil, errMandatoryParam("side")
+	}
+	orderType := binance.OrderType(r.param("type"))
+	timeInForce := binance.TimeInForceType(r.param("timeInForce"))
+	price, hasPrice, err := r.amountParam("price")
+	if err != nil {
+		return nil, err
+	}
+	qty, hasQty, err := r.amountParam("quantity")
+	if err != nil {
+		return nil, err
+	}
+	quoteQty, hasQuoteQty, err := r.amountParam("quoteOrderQty")
+	if err != nil {
+		return nil, err
+	}
+	switch orderType {
+	case binance.OrderTypeLimit:
+		switch timeInForce {
+		case binance.TimeInForceTypeGTC, binance.TimeInForceTypeIOC, binance.TimeInForceTypeFOK:
+		default:
+			return nil, errMandatoryParam("timeInForce")
+		}
+		fallthrough
+	case binance.OrderTypeLimitMaker:
+		if !hasPrice {
+			return nil, errMandatoryParam("price")
+		}
+		if !hasQty {
+			return nil, errMandatoryParam("quantity")
+		}
+	case binance.OrderTypeMarket:
+		if hasQty == hasQuoteQty {
+			return nil, apiError(http.StatusBadRequest, -1102, "Param 'quantity' or 'quoteOrderQty' must be sent, but both were empty/null!")
+		}
+		price = 0
+	case "":
+		return nil, errMandatoryParam("type")
+	default:
+		return nil, apiError(http.StatusBadRequest, -1116, "Invalid orderType.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := sym.checkFilters(price, qty); err != nil {
+		return nil, err
+	}
+	clientOrderID := r.param("newClientOrderId")
+	if clientOrderID == "" {
+		clientOrderID = common.GenerateSpotId()
+	}
+	for _, o := range s.orders {
+		if o.isOpen() && o.clientOrderID == clientOrderID {
+			return nil, apiError(http.StatusBadRequest, common.ErrCodeNewOrderRejected, "Duplicate order sent.")
+		}
+	}
+
+	fills := sym.book.match(side, price, qty, quoteQty, sym.stepSize)
+	var filledQty, filledQuote amount
+	for _, f := range fills {
+		filledQty += f.qty
+		filledQuote += f.price.mul(f.qty)
+	}
+	if orderType == binance.OrderTypeLimitMaker && len(fills) > 0 {
+		return nil, apiError(http.StatusBadRequest, common.ErrCodeNewOrderRejected, "Order would immediately match and take.")
+	}
+	if timeInForce == binance.TimeInForceTypeFOK && filledQty < qty {
+		fills, filledQty, filledQuote = nil, 0, 0
+	}
+	if orderType == binance.OrderTypeMarket && hasQuoteQty {
+		qty = filledQty
+		if qty > 0 {
+			if err := sym.checkFilters(0, qty); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var rest amount
+	if orderType == binance.OrderTypeLimitMaker || (orderType == binance.OrderTypeLimit && timeInForce == binance.TimeInForceTypeGTC) {
+		rest = qty - filledQty
+	}
+	if side == binance.SideTypeBuy {
+		if s.balance(sym.QuoteAsset).free < filledQuote+price.mul(rest) {
+			return nil, errInsufficientBalance
+		}
+	} else if s.balance(sym.BaseAsset).free < filledQty+rest {
+		return nil, errInsufficientBalance
+	}
+	if test {
+		return struct{}{}, nil
+	}
+
+	o := &order{
+		clientOrderID: clientOrderID,
+		symbol:        sym.Symbol,
+		side:          side,
+		orderType:     orderType,
+		timeInForce:   timeInForce,
+		price:         price,
+		origQty:       qty,
+		origQuoteQty:  quoteQty,
+	}
+	trades := s.place(sym, o, fills, rest > 0)
+	res := &binance.CreateOrderResponse{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		ClientOrderID:            o.clientOrderID,
+		TransactTime:             o.time,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		OrigQuoteOrderQuantity:   o.origQuoteQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   o.status,
+		TimeInForce:              o.timeInForce,
+		Type:                     o.orderType,
+		Side:                     o.side,
+		Fills:                    []*binance.Fill{},
+		SelfTradePreventionMode:  binance.SelfTradePreventionModeNone,
+	}
+	for _, t := range trades {
+		res.Fills = append(res.Fills, &binance.Fill{
+			TradeID:         t.ID,
+			Price:           t.Price,
+			Quantity:        t.Quantity,
+			Commission:      t.Commission,
+			CommissionAsset: t.CommissionAsset,
+		})
+	}
+	return res, nil
+}
+
+// place execute the fills of a new order and rest what remains of it on the book when rest is true.
+// It returns the trades of the new order when it belongs to the account.
+func (s *Server) place(sym *symbol, o *order, fills []fill, rest bool) []*binance.TradeV3 {
+	now := s.api.timestamp()
+	s.nextOrderID++
+	o.id = s.nextOrderID
+	o.status = binance.OrderStatusTypeNew
+	o.time, o.updateTime = now, now
+	changed := map[string]bool{}
+	if !o.liquidity {
+		s.orders = append(s.orders, o)
+		s.pushExecutionReport(o, "NEW", nil)
+	}
+	var trades []*binance.TradeV3
+	for _, f := range fills {
+		s.nextTradeID++
+		quote := f.price.mul(f.qty)
+		maker := f.maker
+		maker.executedQty += f.qty
+		maker.cumQuote += quote
+		maker.updateTime = now
+		maker.status = binance.OrderStatusTypePartiallyFilled
+		if maker.remaining() == 0 {
+			maker.status = binance.OrderStatusTypeFilled
+			sym.book.remove(maker)
+		}
+		o.executedQty += f.qty
+		o.cumQuote += quote
+		o.status = binance.OrderStatusTypePartiallyFilled
+		if o.remaining() == 0 {
+			o.status = binance.OrderStatusTypeFilled
+		}
+		if !maker.liquidity {
+			t := s.settle(sym, maker, true, f, changed)
+			s.pushExecutionReport(maker, "TRADE", t)
+		}
+		if !o.liquidity {
+			t := s.settle(sym, o, false, f, changed)
+			trades = append(trades, t)
+			s.pushExecutionReport(o, "TRADE", t)
+		}
+	}
+	switch {
+	case rest && o.remaining() > 0:
+		if !o.liquidity {
+			b := s.lockedBalance(sym, o)
+			o.locked = o.remaining()
+			if o.side == binance.SideTypeBuy {
+				o.locked = o.price.mul(o.remaining())
+			}
+			b.free -= o.locked
+			b.locked += o.locked
+			changed[s.lockedAsset(sym, o)] = true
+		}
+		sym.book.add(o)
+	case o.status != binance.OrderStatusTypeFilled:
+		o.status = binance.OrderStatusTypeExpired
+		if !o.liquidity {
+			s.pushExecutionReport(o, "EXPIRED", nil)
+		}
+	}
+	s.updateID++
+	s.pushAccountPosition(changed)
+	return trades
+}
+
+func (s *Server) lockedAsset(sym *symbol, o *order) string {
+	if o.side == binance.SideTypeBuy {
+		return sym.QuoteAsset
+	}
+	return sym.BaseAsset
+}
+
+func (s *Server) lockedBalance(sym *symbol, o *order) *balance {
+	return s.balance(s.lockedAsset(sym, o))
+}
+
+// settle move the balances of an account order for a fill and record the trade
+func (s *Server) settle(sym *symbol, o *order, isMaker bool, f fill, changed map[string]bool) *binance.TradeV3 {
+	rate := s.takerCommission
+	if isMaker {
+		rate = s.makerCommission
+	}
+	quote := f.price.mul(f.qty)
+	base, quoteBalance := s.balance(sym.BaseAsset), s.balance(sym.QuoteAsset)
+	t := &binance.TradeV3{
+		ID:            s.nextTradeID,
+		Symbol:        sym.Symbol,
+		OrderID:       o.id,
+		OrderListId:   -1,
+		Price:         f.price.String(),
+		Quantity:      f.qty.String(),
+		QuoteQuantity: quote.String(),
+		Time:          o.updateTime,
+		IsBuyer:       o.side == binance.SideTypeBuy,
+		IsMaker:       isMaker,
+		IsBestMatch:   true,
+	}
+	// the balance locked by a resting order is released first
+	paid := quote
+	if o.side == binance.SideTypeSell {
+		paid = f.qty
+	}
+	released := minAmount(o.locked, paid)
+	o.locked -= released
+	if o.side == binance.SideTypeBuy {
+		quoteBalance.locked -= released
+		quoteBalance.free -= paid - released
+		commission := f.qty.mul(rate)
+		base.free += f.qty - commission
+		t.Commission, t.CommissionAsset = commission.String(), sym.BaseAsset
+	} else {
+		base.locked -= released
+		base.free -= paid - released
+		commission := quote.mul(rate)
+		quoteBalance.free += quote - commission
+		t.Commission, t.CommissionAsset = commission.String(), sym.QuoteAsset
+	}
+	if !o.isOpen() && o.locked > 0 {
+		s.release(sym, o)
+	}
+	changed[sym.BaseAsset], changed[sym.QuoteAsset] = true, true
+	s.trades = append(s.trades, t)
+	return t
+}
+
+// release unlock the balance still locked by an order
+func (s *Server) release(sym *symbol, o *order) {
+	b := s.lockedBalance(sym, o)
+	b.locked -= o.locked
+	b.free += o.locked
+	o.locked = 0
+}
+
+// findOrder return the order of orderId or origClientOrderId in orders
+func findOrder(orders []*order, r *request, sym *symbol) (*order, error) {
+	id, err := r.int64Param("orderId", 0)
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := r.param("origClientOrderId")
+	if id == 0 && clientOrderID == "" {
+		return nil, apiError(http.StatusBadRequest, -1102, "Param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null!")
+	}
+	for i := len(orders) - 1; i >= 0; i-- {
+		o := orders[i]
+		if o.symbol == sym.Symbol && ((id != 0 && o.id == id) || (id == 0 && o.clientOrderID == clientOrderID)) {
+			return o, nil
+		}
+	}
+	return nil, errNoSuchOrder
+}
+
+func (s *Server) getOrder(r *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	o, err := findOrder(s.orders, r, sym)
+	if err != nil {
+		return nil, err
+	}
+	return newOrderResponse(o), nil
+}
+
+func (s *Server) cancelOrder(r *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	o, err := findOrder(s.orders, r, sym)
+	if err == errNoSuchOrder || (err == nil && !o.isOpen()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := r.param("newClientOrderId")
+	if clientOrderID == "" {
+		clientOrderID = common.GenerateSpotId()
+	}
+	return s.cancel(sym, o, clientOrderID), nil
+}
+
+func (s *Server) cancel(sym *symbol, o *order, clientOrderID string) *binance.CancelOrderResponse {
+	o.status = binance.OrderStatusTypeCanceled
+	o.updateTime = s.api.timestamp()
+	sym.book.remove(o)
+	s.release(sym, o)
+	s.updateID++
+	origClientOrderID := o.clientOrderID
+	s.pushExecutionReport(o, "CANCELED", nil, clientOrderID)
+	s.pushAccountPosition(map[string]bool{s.lockedAsset(sym, o): true})
+	return &binance.CancelOrderResponse{
+		Symbol:                   o.symbol,
+		OrigClientOrderID:        origClientOrderID,
+		OrderID:                  o.id,
+		OrderListID:              -1,
+		ClientOrderID:            clientOrderID,
+		TransactTime:             o.updateTime,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		OrigQuoteOrderQuantity:   o.origQuoteQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   o.status,
+		TimeInForce:              o.timeInForce,
+		Type:                     o.orderType,
+		Side:                     o.side,
+		SelfTradePreventionMode:  binance.SelfTradePreventionModeNone,
+	}
+}
+
+func (s *Server) openOrders(r *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := r.param("symbol")
+	if _, ok := s.symbols[name]; name != "" && !ok {
+		return nil, errBadSymbol
+	}
+	res := []*binance.Order{}
+	for _, o := range s.orders {
+		if o.isOpen() && (name == "" || o.symbol == name) {
+			res = append(res, newOrderResponse(o))
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) cancelOpenOrders(r *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	res := []*binance.CancelOrderResponse{}
+	for _, o := range s.orders {
+		if o.isOpen() && o.symbol == sym.Symbol {
+			res = append(res, s.cancel(sym, o, common.GenerateSpotId()))
+		}
+	}
+	if len(res) == 0 {
+		return nil, errUnknownOrder
+	}
+	return res, nil
+}
+
+// timeRange read the startTime, endTime and limit parameters of the history endpoints
+func timeRange(r *request, defaultLimit int64) (startTime, endTime int64, limit int, err error) {
+	if startTime, err = r.int64Param("startTime", 0); err != nil {
+		return
+	}
+	if endTime, err = r.int64Param("endTime", 0); err != nil {
+		return
+	}
+	l, err := r.int64Param("limit", defaultLimit)
+	if err != nil {
+		return
+	}
+	if l <= 0 || l > 1000 {
+		err = errInvalidParam("limit")
+		return
+	}
+	return startTime, endTime, int(l), nil
+}
+
+func inRange(t, startTime, endTime int64) bool {
+	return (startTime == 0 || t >= startTime) && (endTime == 0 || t <= endTime)
+}
+
+// page return the first limit items when the first id or the start time is set, the last limit items otherwise
+func page(n, limit int, fromID bool) (from, to int) {
+	if n <= limit {
+		return 0, n
+	}
+	if fromID {
+		return 0, limit
+	}
+	return n - limit, n
+}
+
+func (s *Server) allOrders(r *request) (interface{}, error) {
+	startTime, endTime, limit, err := timeRange(r, 500)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := r.int64Param("orderId", 0)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	res := []*binance.Order{}
+	for _, o := range s.orders {
+		if o.symbol == sym.Symbol && o.id >= orderID && inRange(o.time, startTime, endTime) {
+			res = append(res, newOrderResponse(o))
+		}
+	}
+	from, to := page(len(res), limit, r.param("orderId") != "" || startTime != 0)
+	return res[from:to], nil
+}
+
+func (s *Server) myTrades(r *request) (interface{}, error) {
+	startTime, endTime, limit, err := timeRange(r, 500)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := r.int64Param("orderId", 0)
+	if err != nil {
+		return nil, err
+	}
+	fromID, err := r.int64Param("fromId", 0)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.symbol(r)
+	if err != nil {
+		return nil, err
+	}
+	res := []*binance.TradeV3{}
+	for _, t := range s.trades {
+		if t.Symbol == sym.Symbol && (orderID == 0 || t.OrderID == orderID) && t.ID >= fromID &&
+			inRange(t.Time, startTime, endTime) {
+			res = append(res, t)
+		}
+	}
+	from, to := page(len(res), limit, r.param("fromId") != "" || startTime != 0)
+	return res[from:to], nil
+}
+
+func (s *Server) account(r *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &binance.Account{
+		MakerCommission: int64(s.makerCommission) / 10000,
+		TakerCommission: int64(s.takerCommission) / 10000,
+		CommissionRates: binance.CommissionRates{
+			Maker:  s.makerCommission.String(),
+			Taker:  s.takerCommission.String(),
+			Buyer:  amount(0).String(),
+			Seller: amount(0).String(),
+		},
+		CanTrade:    true,
+		CanWithdraw: true,
+		CanDeposit:  true,
+		UpdateTime:  uint64(s.api.timestamp()),
+		AccountType: "SPOT",
+		Balances:    []binance.Balance{},
+		Permissions: []string{"SPOT"},
+	}
+	assets := make([]string, 0, len(s.balances))
+	for asset := range s.balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	for _, asset := range assets {
+		b := s.balances[asset]
+		res.Balances = append(res.Balances, binance.Balance{Asset: asset, Free: b.free.String(), Locked: b.locked.String()})
+	}
+	return res, nil
+}
+
+func newOrderResponse(o *order) *binance.Order {
+	return &binance.Order{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		OrderListId:              -1,
+		ClientOrderID:            o.clientOrderID,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   o.status,
+		TimeInForce:              o.timeInForce,
+		Type:                     o.orderType,
+		Side:                     o.side,
+		StopPrice:                amount(0).String(),
+		IcebergQuantity:          amount(0).String(),
+		Time:                     o.time,
+		UpdateTime:               o.updateTime,
+		IsWorking:                true,
+		OrigQuoteOrderQuantity:   o.origQuoteQty.String(),
+	}
+}
+
+type executionReport struct {
+	Event binance.UserDataEventType `json:"e"`
+	Time  int64                     `json:"E"`
+	binance.WsOrderUpdate
+}
+
+type accountPosition struct {
+	Event binance.UserDataEventType `json:"e"`
+	Time  int64                     `json:"E"`
+	binance.WsAccountUpdateList
+}
+
+// pushExecutionReport push the executionReport of an account order, t is the trade of TRADE executions.
+// The client order id of the cancel request is given for CANCELED executions.
+func (s *Server) pushExecutionReport(o *order, executionType string, t *binance.TradeV3, cancelClientOrderID ...string) {
+	e := &executionReport{
+		Event: binance.UserDataEventTypeExecutionReport,
+		Time:  s.api.timestamp(),
+		WsOrderUpdate: binance.WsOrderUpdate{
+			Symbol:                  o.symbol,
+			ClientOrderId:           o.clientOrderID,
+			Side:                    string(o.side),
+			Type:                    string(o.orderType),
+			TimeInForce:             o.timeInForce,
+			Volume:                  o.origQty.String(),
+			Price:                   o.price.String(),
+			StopPrice:               amount(0).String(),
+			IceBergVolume:           amount(0).String(),
+			OrderListId:             -1,
+			ExecutionType:           executionType,
+			Status:                  string(o.status),
+			RejectReason:            "NONE",
+			Id:                      o.id,
+			LatestVolume:            amount(0).String(),
+			FilledVolume:            o.executedQty.String(),
+			LatestPrice:             amount(0).String(),
+			FeeCost:                 amount(0).String(),
+			TransactionTime:         o.updateTime,
+			TradeId:                 -1,
+			IsInOrderBook:           o.isOpen(),
+			CreateTime:              o.time,
+			FilledQuoteVolume:       o.cumQuote.String(),
+			LatestQuoteVolume:       amount(0).String(),
+			QuoteVolume:             o.origQuoteQty.String(),
+			SelfTradePreventionMode: string(binance.SelfTradePreventionModeNone),
+		},
+	}
+	if len(cancelClientOrderID) > 0 {
+		e.ClientOrderId = cancelClientOrderID[0]
+		e.OrigCustomOrderId = o.clientOrderID
+	}
+	if t != nil {
+		e.LatestVolume = t.Quantity
+		e.LatestPrice = t.Price
+		e.LatestQuoteVolume = t.QuoteQuantity
+		e.FeeCost = t.Commission
+		e.FeeAsset = t.CommissionAsset
+		e.TradeId = t.ID
+		e.IsMaker = t.IsMaker
+	}
+	s.streams.push(e)
+}
+
+// pushAccountPosition push the outboundAccountPosition of the changed assets
+func (s *Server) pushAccountPosition(changed map[string]bool) {
+	if len(changed) == 0 {
+		return
+	}
+	now := s.api.timestamp()
+	e := &accountPosition{
+		Event: binance.UserDataEventTypeOutboundAccountPosition,
+		Time:  now,
+		WsAccountUpdateList: binance.WsAccountUpdateList{
+			AccountUpdateTime: now,
+		},
+	}
+	assets := make([]string, 0, len(changed))
+	for asset := range changed {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	for _, asset := range assets {
+		b := s.balance(asset)
+		e.WsAccountUpdates = append(e.WsAccountUpdates, binance.WsAccountUpdate{
+			Asset:  asset,
+			Free:   b.free.String(),
+			Locked: b.locked.String(),
+		})
+	}
+	s.streams.push(e)
+}
@@ -0,0 +1,287 @@
+package binancetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type serverTestSuite struct {
+	suite.Suite
+	server *Server
+	client *binance.Client
+	events chan *binance.WsUserDataEvent
+	stopC  chan struct{}
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(serverTestSuite))
+}
+
+func (s *serverTestSuite) SetupTest() {
+	s.server = NewServer(Config{
+		APIKey:          "apiKey",
+		SecretKey:       "secretKey",
+		Balances:        map[string]string{"USDT": "10000", "BTC": "1"},
+		MakerCommission: "0.001",
+		TakerCommission: "0.002",
+	})
+	s.client = s.server.NewClient()
+
+	listenKey, err := s.client.NewStartUserStreamService().Do(context.Background())
+	s.Require().NoError(err)
+	events := make(chan *binance.WsUserDataEvent, 100)
+	s.events = events
+	_, s.stopC, err = s.client.Environment.WsUserDataServe(listenKey, func(event *binance.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+}
+
+func (s *serverTestSuite) TearDownTest() {
+	close(s.stopC)
+	s.server.Close()
+}
+
+// nextEvent wait for the next user data event
+func (s *serverTestSuite) nextEvent() *binance.WsUserDataEvent {
+	select {
+	case e := <-s.events:
+		return e
+	case <-time.After(5 * time.Second):
+		s.FailNow("no user data event")
+		return nil
+	}
+}
+
+func (s *serverTestSuite) nextOrderUpdate() binance.WsOrderUpdate {
+	e := s.nextEvent()
+	s.Require().Equal(binance.UserDataEventTypeExecutionReport, e.Event)
+	return e.OrderUpdate
+}
+
+func (s *serverTestSuite) nextAccountUpdate() map[string]binance.WsAccountUpdate {
+	e := s.nextEvent()
+	s.Require().Equal(binance.UserDataEventTypeOutboundAccountPosition, e.Event)
+	balances := map[string]binance.WsAccountUpdate{}
+	for _, b := range e.AccountUpdate.WsAccountUpdates {
+		balances[b.Asset] = b
+	}
+	return balances
+}
+
+func (s *serverTestSuite) TestLimitOrderLifecycle() {
+	ctx := context.Background()
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Price("30000").Quantity("0.1").NewClientOrderID("buy-1").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeNew, order.Status)
+	s.Equal("buy-1", order.ClientOrderID)
+	s.Equal("NEW", s.nextOrderUpdate().ExecutionType)
+	s.Equal("3000.00000000", s.nextAccountUpdate()["USDT"].Locked)
+
+	depth, err := s.client.NewDepthService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(depth.Bids, 1)
+	s.Equal(binance.Bid{Price: "30000.00000000", Quantity: "0.10000000"}, depth.Bids[0])
+
+	// a seller trades with the resting order at its price
+	_, err = s.server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "29990", "0.04")
+	s.Require().NoError(err)
+	update := s.nextOrderUpdate()
+	s.Equal("TRADE", update.ExecutionType)
+	s.Equal("PARTIALLY_FILLED", update.Status)
+	s.Equal("0.04000000", update.LatestVolume)
+	s.Equal("30000.00000000", update.LatestPrice)
+	s.True(update.IsMaker)
+	s.Equal("0.00004000", update.FeeCost)
+	s.Equal("BTC", update.FeeAsset)
+	balances := s.nextAccountUpdate()
+	s.Equal("1.03996000", balances["BTC"].Free)
+	s.Equal("7000.00000000", balances["USDT"].Free)
+	s.Equal("1800.00000000", balances["USDT"].Locked)
+
+	open, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(open, 1)
+	s.Equal("0.04000000", open[0].ExecutedQuantity)
+
+	canceled, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrigClientOrderID("buy-1").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, canceled.Status)
+	s.Equal("buy-1", canceled.OrigClientOrderID)
+	update = s.nextOrderUpdate()
+	s.Equal("CANCELED", update.ExecutionType)
+	s.Equal("buy-1", update.OrigCustomOrderId)
+	s.Equal("8800.00000000", s.nextAccountUpdate()["USDT"].Free)
+
+	got, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, got.Status)
+
+	trades, err := s.client.NewListTradesService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 1)
+	s.Equal(order.OrderID, trades[0].OrderID)
+	s.True(trades[0].IsBuyer)
+	s.True(trades[0].IsMaker)
+	s.Equal("1200.00000000", trades[0].QuoteQuantity)
+
+	_, err = s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(ctx)
+	s.ErrorIs(err, common.ErrCancelRejected)
+}
+
+func (s *serverTestSuite) TestMarketOrders() {
+	ctx := context.Background()
+	_, err := s.server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "30100", "0.01")
+	s.Require().NoError(err)
+	_, err = s.server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "30000", "0.01")
+	s.Require().NoError(err)
+	_, err = s.server.AddLiquidity("BTCUSDT", binance.SideTypeBuy, "29000", "1")
+	s.Require().NoError(err)
+
+	// best price first
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.015").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, order.Status)
+	s.Require().Len(order.Fills, 2)
+	s.Equal("30000.00000000", order.Fills[0].Price)
+	s.Equal("0.01000000", order.Fills[0].Quantity)
+	s.Equal("30100.00000000", order.Fills[1].Price)
+	s.Equal("0.00500000", order.Fills[1].Quantity)
+	s.Equal("450.50000000", order.CummulativeQuoteQuantity)
+
+	// by quote amount, the quantity is rounded down to the step size
+	order, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).QuoteOrderQty("100").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, order.Status)
+	s.Equal("0.00344000", order.ExecutedQuantity)
+	s.Equal("99.76000000", order.CummulativeQuoteQuantity)
+
+	// IOC orders expire what they can not fill
+	order, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeIOC).
+		Price("30100").Quantity("0.01").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeExpired, order.Status)
+	s.Equal("0.00500000", order.ExecutedQuantity)
+
+	orders, err := s.client.NewListOrdersService().Symbol("BTCUSDT").Do(ctx)
+	s.Require().NoError(err)
+	s.Len(orders, 3)
+	account, err := s.client.NewGetAccountService().Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(10), account.MakerCommission)
+	s.Equal(int64(20), account.TakerCommission)
+	s.Require().Len(account.Balances, 2)
+	s.Equal(binance.Balance{Asset: "BTC", Free: "1.01652000", Locked: "0.00000000"}, account.Balances[0])
+}
+
+func (s *serverTestSuite) TestRejections() {
+	ctx := context.Background()
+	newOrder := func() *binance.CreateOrderService {
+		return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+			Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC)
+	}
+	_, err := newOrder().Price("30000.001").Quantity("0.1").Do(ctx)
+	s.ErrorIs(err, common.ErrInvalidMessage)
+	_, err = newOrder().Price("1").Quantity("0.1").Do(ctx)
+	s.ErrorIs(err, common.ErrInvalidMessage)
+	_, err = newOrder().Price("30000").Quantity("1").Do(ctx)
+	s.ErrorIs(err, common.ErrNewOrderRejected)
+	_, err = newOrder().Symbol("ETHUSDT").Price("30000").Quantity("0.1").Do(ctx)
+	s.ErrorIs(err, common.ErrBadSymbol)
+
+	_, err = s.server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "30000", "0.01")
+	s.Require().NoError(err)
+	_, err = newOrder().Type(binance.OrderTypeLimitMaker).Price("30000").Quantity("0.01").Do(ctx)
+	s.ErrorIs(err, common.ErrNewOrderRejected)
+	order, err := newOrder().TimeInForce(binance.TimeInForceTypeFOK).Price("30000").Quantity("0.02").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeExpired, order.Status)
+	s.Empty(order.Fills)
+	s.NoError(newOrder().Price("30000").Quantity("0.01").Test(ctx))
+
+	// signatures and timestamps are verified
+	c := binance.NewClient("apiKey", "wrongSecretKey", s.server.Environment())
+	_, err = c.NewGetAccountService().Do(ctx)
+	s.ErrorIs(err, common.ErrInvalidSignature)
+	c = binance.NewClient("wrongAPIKey", "secretKey", s.server.Environment())
+	_, err = c.NewGetAccountService().Do(ctx)
+	s.ErrorIs(err, common.ErrRejectedAPIKey)
+	s.client.TimeOffset = 10000
+	_, err = s.client.NewGetAccountService().Do(ctx)
+	s.ErrorIs(err, common.ErrInvalidTimestamp)
+}
+
+func TestServerExchangeInfo(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	info, err := server.NewClient().NewExchangeInfoService().Symbol("BTCUSDT").Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, info.Symbols, 1)
+	symbol := info.Symbols[0]
+	assert.Equal(t, "BTC", symbol.BaseAsset)
+	assert.Equal(t, "0.01000000", symbol.PriceFilter().TickSize)
+	assert.Equal(t, "0.00001000", symbol.LotSizeFilter().StepSize)
+	assert.Equal(t, "5.00000000", symbol.NotionalFilter().MinNotional)
+}
+
+func TestAmount(t *testing.T) {
+	for s, v := range map[string]amount{
+		"1":           100000000,
+		"0.00000001":  1,
+		"-1.5":        -150000000,
+		"30000.10":    3000010000000,
+		".5":          50000000,
+		"2.000000000": 200000000,
+	} {
+		a, err := parseAmount(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, v, a, s)
+	}
+	for _, s := range []string{"", "abc", "1.000000001", "."} {
+		_, err := parseAmount(s)
+		assert.Error(t, err, s)
+	}
+	assert.Equal(t, "0.00344000", mustAmount("100").div(mustAmount("29000")).floor(mustAmount("0.00001")).String())
+	assert.Equal(t, "450.00000000", mustAmount("30000").mul(mustAmount("0.015")).String())
+}
+
+func (s *serverTestSuite) TestIterators() {
+	ctx := context.Background()
+	_, err := s.server.AddLiquidity("BTCUSDT", binance.SideTypeSell, "30000", "0.05")
+	s.Require().NoError(err)
+	for i := 0; i < 5; i++ {
+		_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+			Type(binance.OrderTypeMarket).Quantity("0.01").Do(ctx)
+		s.Require().NoError(err)
+	}
+
+	trades, err := s.client.NewListTradesService().Symbol("BTCUSDT").Limit(2).Iterator().All(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 5)
+	for i := 1; i < len(trades); i++ {
+		s.Greater(trades[i].ID, trades[i-1].ID)
+	}
+
+	it := s.client.NewListOrdersService().Symbol("BTCUSDT").Limit(2).
+		StartTime(trades[0].Time).EndTime(trades[4].Time).Iterator()
+	var orders []*binance.Order
+	for it.Next(ctx) {
+		orders = append(orders, it.Item())
+	}
+	s.Require().NoError(it.Err())
+	s.Require().Len(orders, 5)
+	s.Equal(trades[4].OrderID, orders[4].OrderID)
+}
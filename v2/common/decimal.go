@@ -0,0 +1,24 @@
+package common
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ParseDecimal parse a decimal string of the API, the empty string is zero
+func ParseDecimal(s string) (decimal.Decimal, error) {
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(s)
+}
+
+// ToDecimal parse a decimal string of the API, zero when it is empty or invalid. It suits the optional
+// fields such as the filters, the decimal getters of the responses use ParseDecimal to report the
+// malformed values.
+func ToDecimal(s string) decimal.Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
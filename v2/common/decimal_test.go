@@ -0,0 +1,39 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimal(t *testing.T) {
+	d, err := ParseDecimal("0.10000000")
+	require.NoError(t, err)
+	assert.Equal(t, "0.1", d.String())
+	d, err = ParseDecimal("")
+	require.NoError(t, err)
+	assert.True(t, d.IsZero())
+	_, err = ParseDecimal("abc")
+	assert.Error(t, err)
+	assert.True(t, ToDecimal("abc").IsZero())
+
+	// no float rounding
+	assert.Equal(t, "0.3", ToDecimal("0.1").Add(ToDecimal("0.2")).String())
+
+	level := PriceLevel{Price: "30000.01000000", Quantity: "0.00100000"}
+	price, quantity, err := level.ParseDecimal()
+	require.NoError(t, err)
+	assert.True(t, price.Equal(decimal.RequireFromString("30000.01")))
+	levelPrice, err := level.PriceDecimal()
+	require.NoError(t, err)
+	levelQuantity, err := level.QuantityDecimal()
+	require.NoError(t, err)
+	assert.True(t, quantity.Equal(levelQuantity))
+	assert.Equal(t, "30.00001", levelPrice.Mul(levelQuantity).String())
+	_, _, err = (&PriceLevel{Price: "1", Quantity: "x"}).ParseDecimal()
+	assert.Error(t, err)
+	_, err = (&PriceLevel{Price: "1", Quantity: "x"}).QuantityDecimal()
+	assert.Error(t, err)
+}
@@ -1,14 +1,18 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
-	Code     int64  `json:"code"`
-	Message  string `json:"msg"`
-	Response []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+	Code       int64  `json:"code"`
+	Message    string `json:"msg"`
+	Response   []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+	StatusCode int    `json:"-"` // HTTP status code of the response, 0 if unknown
 }
 
 // Error return error code and message
@@ -23,8 +27,180 @@ func (e APIError) IsValid() bool {
 	return e.Code != 0 || e.Message != ""
 }
 
+// Is report whether target is an APIError with the same code,
+// so that errors.Is(err, common.ErrInvalidTimestamp) works through wrapping
+func (e APIError) Is(target error) bool {
+	var t *APIError
+	switch v := target.(type) {
+	case *APIError:
+		t = v
+	case APIError:
+		t = &v
+	default:
+		return false
+	}
+	return t != nil && t.Code != 0 && t.Code == e.Code
+}
+
+// As allow errors.As to find an APIError returned by value when the target is a **APIError
+func (e APIError) As(target interface{}) bool {
+	if t, ok := target.(**APIError); ok {
+		c := e
+		*t = &c
+		return true
+	}
+	return false
+}
+
 // IsAPIError check if e is an API error
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
-	return ok
+	var apiErr *APIError
+	return errors.As(e, &apiErr)
+}
+
+// AsAPIError return the APIError wrapped in err, or nil if there is none
+func AsAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return nil
+}
+
+// Error codes documented by Binance, shared by spot, futures, delivery and options
+const (
+	ErrCodeUnknown                      int64 = -1000
+	ErrCodeDisconnected                 int64 = -1001
+	ErrCodeUnauthorized                 int64 = -1002
+	ErrCodeTooManyRequests              int64 = -1003
+	ErrCodeUnexpectedResponse           int64 = -1006
+	ErrCodeTimeout                      int64 = -1007
+	ErrCodeServerBusy                   int64 = -1008
+	ErrCodeInvalidMessage               int64 = -1013
+	ErrCodeTooManyOrders                int64 = -1015
+	ErrCodeServiceShuttingDown          int64 = -1016
+	ErrCodeInvalidTimestamp             int64 = -1021
+	ErrCodeInvalidSignature             int64 = -1022
+	ErrCodeBadPrecision                 int64 = -1111
+	ErrCodeBadSymbol                    int64 = -1121
+	ErrCodeNewOrderRejected             int64 = -2010
+	ErrCodeCancelRejected               int64 = -2011
+	ErrCodeNoSuchOrder                  int64 = -2013
+	ErrCodeBadAPIKeyFormat              int64 = -2014
+	ErrCodeRejectedAPIKey               int64 = -2015
+	ErrCodeBalanceNotSufficient         int64 = -2018
+	ErrCodeMarginNotSufficient          int64 = -2019
+	ErrCodeOrderWouldImmediatelyTrigger int64 = -2021
+	ErrCodeReduceOnlyRejected           int64 = -2022
+	ErrCodeBalanceNotEnough             int64 = -3041
+	ErrCodeMarketOrderRejected          int64 = -4131
+	ErrCodeMinNotional                  int64 = -4164
+	ErrCodeFOKOrderRejected             int64 = -5021
+	ErrCodePostOnlyRejected             int64 = -5022
+)
+
+// Sentinel errors for the documented error codes, match them with errors.Is
+var (
+	ErrUnknown                      = &APIError{Code: ErrCodeUnknown, Message: "An unknown error occurred while processing the request."}
+	ErrDisconnected                 = &APIError{Code: ErrCodeDisconnected, Message: "Internal error; unable to process your request. Please try again."}
+	ErrUnauthorized                 = &APIError{Code: ErrCodeUnauthorized, Message: "You are not authorized to execute this request."}
+	ErrTooManyRequests              = &APIError{Code: ErrCodeTooManyRequests, Message: "Too many requests queued."}
+	ErrUnexpectedResponse           = &APIError{Code: ErrCodeUnexpectedResponse, Message: "An unexpected response was received from the message bus."}
+	ErrTimeout                      = &APIError{Code: ErrCodeTimeout, Message: "Timeout waiting for response from backend server."}
+	ErrServerBusy                   = &APIError{Code: ErrCodeServerBusy, Message: "Server is currently overloaded with other requests."}
+	ErrInvalidMessage               = &APIError{Code: ErrCodeInvalidMessage, Message: "Filter failure."}
+	ErrTooManyOrders                = &APIError{Code: ErrCodeTooManyOrders, Message: "Too many new orders."}
+	ErrServiceShuttingDown          = &APIError{Code: ErrCodeServiceShuttingDown, Message: "This service is no longer available."}
+	ErrInvalidTimestamp             = &APIError{Code: ErrCodeInvalidTimestamp, Message: "Timestamp for this request is outside of the recvWindow."}
+	ErrInvalidSignature             = &APIError{Code: ErrCodeInvalidSignature, Message: "Signature for this request is not valid."}
+	ErrBadPrecision                 = &APIError{Code: ErrCodeBadPrecision, Message: "Precision is over the maximum defined for this asset."}
+	ErrBadSymbol                    = &APIError{Code: ErrCodeBadSymbol, Message: "Invalid symbol."}
+	ErrNewOrderRejected             = &APIError{Code: ErrCodeNewOrderRejected, Message: "New order rejected."}
+	ErrCancelRejected               = &APIError{Code: ErrCodeCancelRejected, Message: "Cancel rejected."}
+	ErrNoSuchOrder                  = &APIError{Code: ErrCodeNoSuchOrder, Message: "Order does not exist."}
+	ErrBadAPIKeyFormat              = &APIError{Code: ErrCodeBadAPIKeyFormat, Message: "API-key format invalid."}
+	ErrRejectedAPIKey               = &APIError{Code: ErrCodeRejectedAPIKey, Message: "Invalid API-key, IP, or permissions for action."}
+	ErrBalanceNotSufficient         = &APIError{Code: ErrCodeBalanceNotSufficient, Message: "Balance is insufficient."}
+	ErrMarginNotSufficient          = &APIError{Code: ErrCodeMarginNotSufficient, Message: "Margin is insufficient."}
+	ErrOrderWouldImmediatelyTrigger = &APIError{Code: ErrCodeOrderWouldImmediatelyTrigger, Message: "Order would immediately trigger."}
+	ErrReduceOnlyRejected           = &APIError{Code: ErrCodeReduceOnlyRejected, Message: "ReduceOnly Order is rejected."}
+	ErrBalanceNotEnough             = &APIError{Code: ErrCodeBalanceNotEnough, Message: "Balance is not enough."}
+	ErrMarketOrderRejected          = &APIError{Code: ErrCodeMarketOrderRejected, Message: "The counterparty's best price does not meet the PERCENT_PRICE filter limit."}
+	ErrMinNotional                  = &APIError{Code: ErrCodeMinNotional, Message: "Order's notional is smaller than the minimum notional."}
+	ErrFOKOrderRejected             = &APIError{Code: ErrCodeFOKOrderRejected, Message: "Due to the order could not be filled immediately, the FOK order has been rejected."}
+	ErrPostOnlyRejected             = &APIError{Code: ErrCodePostOnlyRejected, Message: "Due to the order could not be executed as maker, the Post Only order will be rejected."}
+)
+
+// HTTP 418 is returned once an IP has been auto-banned for continuing to send requests after HTTP 429
+const statusIPBanned = 418
+
+// IsRateLimited report whether err was caused by exceeding a request or order rate limit,
+// including HTTP 429 and the HTTP 418 IP ban
+func IsRateLimited(err error) bool {
+	apiErr := AsAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode == statusIPBanned {
+		return true
+	}
+	switch apiErr.Code {
+	case ErrCodeTooManyRequests, ErrCodeTooManyOrders:
+		return true
+	}
+	return false
+}
+
+// IsInsufficientBalance report whether err was caused by an insufficient balance or margin
+func IsInsufficientBalance(err error) bool {
+	apiErr := AsAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeBalanceNotSufficient, ErrCodeMarginNotSufficient, ErrCodeBalanceNotEnough:
+		return true
+	case ErrCodeNewOrderRejected:
+		// spot reports "Account has insufficient balance for requested action."
+		return strings.Contains(strings.ToLower(apiErr.Message), "insufficient balance")
+	}
+	return false
+}
+
+// IsRetryable report whether err is transient and the same request may succeed when sent again later:
+// rate limits (but not IP bans), timestamp errors and server side failures.
+// Server side failures leave the execution status unknown, see IsIdempotentMethod before retrying orders.
+func IsRetryable(err error) bool {
+	apiErr := AsAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	if apiErr.StatusCode == statusIPBanned {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError {
+		return true
+	}
+	switch apiErr.Code {
+	case ErrCodeUnknown, ErrCodeDisconnected, ErrCodeTooManyRequests, ErrCodeUnexpectedResponse,
+		ErrCodeTimeout, ErrCodeServerBusy, ErrCodeTooManyOrders, ErrCodeInvalidTimestamp:
+		return true
+	}
+	return false
+}
+
+// IsOrderRejected report whether err means a new order was rejected by the matching engine or by the
+// symbol filters, e.g. filter failures, min notional, post-only, FOK or reduce-only rejections
+func IsOrderRejected(err error) bool {
+	apiErr := AsAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeInvalidMessage, ErrCodeNewOrderRejected, ErrCodeOrderWouldImmediatelyTrigger,
+		ErrCodeReduceOnlyRejected, ErrCodeMarketOrderRejected, ErrCodeMinNotional,
+		ErrCodeFOKOrderRejected, ErrCodePostOnlyRejected:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIsAs(t *testing.T) {
+	err := fmt.Errorf("place order: %w", &APIError{Code: -2010, Message: "Account has insufficient balance for requested action."})
+
+	assert.True(t, errors.Is(err, ErrNewOrderRejected))
+	assert.False(t, errors.Is(err, ErrCancelRejected))
+	assert.True(t, IsAPIError(err))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, int64(-2010), apiErr.Code)
+
+	// APIError returned by value, e.g. in the results of batch order services
+	err = fmt.Errorf("batch: %w", APIError{Code: -5022})
+	assert.True(t, errors.Is(err, ErrPostOnlyRejected))
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, int64(-5022), apiErr.Code)
+
+	assert.False(t, IsAPIError(errors.New("dummy error")))
+	assert.Nil(t, AsAPIError(errors.New("dummy error")))
+	assert.False(t, errors.Is(&APIError{Response: []byte("bad gateway")}, &APIError{}))
+}
+
+func TestAPIErrorCategories(t *testing.T) {
+	tests := []struct {
+		name                string
+		err                 error
+		rateLimited         bool
+		insufficientBalance bool
+		retryable           bool
+		orderRejected       bool
+	}{
+		{name: "too many requests", err: &APIError{Code: -1003, StatusCode: http.StatusTooManyRequests}, rateLimited: true, retryable: true},
+		{name: "ip banned", err: &APIError{Code: -1003, StatusCode: 418}, rateLimited: true},
+		{name: "too many orders", err: &APIError{Code: -1015, StatusCode: http.StatusBadRequest}, rateLimited: true, retryable: true},
+		{name: "timestamp", err: &APIError{Code: -1021, StatusCode: http.StatusBadRequest}, retryable: true},
+		{name: "gateway", err: &APIError{Response: []byte("<html>"), StatusCode: http.StatusBadGateway}, retryable: true},
+		{name: "spot insufficient balance", err: &APIError{Code: -2010, Message: "Account has insufficient balance for requested action."}, insufficientBalance: true, orderRejected: true},
+		{name: "futures margin", err: fmt.Errorf("wrapped: %w", &APIError{Code: -2019}), insufficientBalance: true},
+		{name: "min notional", err: &APIError{Code: -4164}, orderRejected: true},
+		{name: "post only", err: &APIError{Code: -5022}, orderRejected: true},
+		{name: "cancel rejected", err: &APIError{Code: -2011}},
+		{name: "not an api error", err: errors.New("dummy error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.rateLimited, IsRateLimited(tt.err), "IsRateLimited")
+			assert.Equal(t, tt.insufficientBalance, IsInsufficientBalance(tt.err), "IsInsufficientBalance")
+			assert.Equal(t, tt.retryable, IsRetryable(tt.err), "IsRetryable")
+			assert.Equal(t, tt.orderRejected, IsOrderRejected(tt.err), "IsOrderRejected")
+		})
+	}
+}
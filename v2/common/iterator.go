@@ -0,0 +1,309 @@
+package common
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// PageFunc fetch the next page of a paginated endpoint, done is true when no page follows it.
+// A page may be empty without being the last one, e.g. an empty time window.
+type PageFunc[T any] func(ctx context.Context) (items []T, done bool, err error)
+
+// Iterator iterate over the items of a paginated endpoint, the pages are fetched lazily by Next
+// through the client and its rate limiter
+//
+//	it := client.NewListTradesService().Symbol("BTCUSDT").StartTime(start).Iterator()
+//	for it.Next(ctx) {
+//		trade := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch PageFunc[T]
+	items []T
+	item  T
+	done  bool
+	err   error
+}
+
+// NewIterator create an iterator over the pages returned by fetch
+func NewIterator[T any](fetch PageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch}
+}
+
+// Next advance to the next item, it returns false after the last item or on error, see Err.
+// The iteration can be resumed by calling Next again after an error.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if it.done {
+			return false
+		}
+		items, done, err := it.fetch(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items, it.done, it.err = items, done, nil
+	}
+	it.item, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Item return the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err return the error which stopped the iteration, nil at the end of the items
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All return the remaining items
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for it.Next(ctx) {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// IDPager page an endpoint whose items have increasing ids and which returns the items from a given id,
+// e.g. trades or orders. The first id after StartTime is searched by time windows of at most Window.
+type IDPager[T any] struct {
+	// FromID is the first id, StartTime is used to find it when nil, the first item otherwise
+	FromID    *int64
+	StartTime *int64
+	// EndTime stops the iteration, no limit when nil
+	EndTime *int64
+	Window  time.Duration
+	Limit   int
+	// Now return the current timestamp in milliseconds, it bounds the search of the first id
+	Now func() int64
+	// ByID fetch at most Limit items from id, ByTime the items between start and end included
+	ByID   func(ctx context.Context, id int64) ([]T, error)
+	ByTime func(ctx context.Context, start, end int64) ([]T, error)
+	ID     func(item T) int64
+	Time   func(item T) int64
+}
+
+// Pages return the PageFunc of the pager
+func (p IDPager[T]) Pages() PageFunc[T] {
+	var next *int64
+	if p.FromID != nil {
+		next = p.FromID
+	} else if p.StartTime == nil {
+		next = new(int64)
+	}
+	var start int64
+	if p.StartTime != nil {
+		start = *p.StartTime
+	}
+	// keep filters the items of a page, it returns false after EndTime
+	keep := func(item T) (bool, bool) {
+		if p.StartTime != nil && p.Time(item) < *p.StartTime {
+			return false, true
+		}
+		if p.EndTime != nil && p.Time(item) > *p.EndTime {
+			return false, false
+		}
+		return true, true
+	}
+	return func(ctx context.Context) ([]T, bool, error) {
+		if next == nil {
+			// search the first item by time windows
+			end := p.Now()
+			if p.EndTime != nil && *p.EndTime < end {
+				end = *p.EndTime
+			}
+			if start > end {
+				return nil, true, nil
+			}
+			windowEnd := start + p.Window.Milliseconds() - 1
+			if windowEnd > end {
+				windowEnd = end
+			}
+			items, err := p.ByTime(ctx, start, windowEnd)
+			if err != nil {
+				return nil, false, err
+			}
+			start = windowEnd + 1
+			if len(items) == 0 {
+				return nil, start > end, nil
+			}
+			page := make([]T, 0, len(items))
+			for _, item := range items {
+				ok, more := keep(item)
+				if !more {
+					return page, true, nil
+				}
+				if ok {
+					page = append(page, item)
+				}
+			}
+			id := p.ID(items[len(items)-1]) + 1
+			next = &id
+			return page, false, nil
+		}
+		items, err := p.ByID(ctx, *next)
+		if err != nil {
+			return nil, false, err
+		}
+		page := make([]T, 0, len(items))
+		for _, item := range items {
+			// drop the items overlapping the previous page
+			if p.ID(item) < *next {
+				continue
+			}
+			ok, more := keep(item)
+			if !more {
+				return page, true, nil
+			}
+			if ok {
+				page = append(page, item)
+			}
+		}
+		if len(items) < p.Limit || len(page) == 0 && p.ID(items[len(items)-1]) < *next {
+			return page, true, nil
+		}
+		id := p.ID(items[len(items)-1]) + 1
+		next = &id
+		return page, false, nil
+	}
+}
+
+// timeBounds return the first and last timestamps to iterate, the last Window before end by default
+func timeBounds(startTime, endTime *int64, window time.Duration, now func() int64) (start, end int64) {
+	end = now()
+	if endTime != nil {
+		end = *endTime
+	}
+	if startTime != nil {
+		start = *startTime
+	} else if window > 0 {
+		start = end - window.Milliseconds() + 1
+	}
+	return start, end
+}
+
+// windowEnd return the end of the time window starting at start
+func windowEnd(start, end int64, window time.Duration) int64 {
+	if window > 0 && start+window.Milliseconds()-1 < end {
+		return start + window.Milliseconds() - 1
+	}
+	return end
+}
+
+// TimePager page an endpoint by moving its start time to the last item, e.g. klines or incomes.
+// The items sharing the time of the last item of a page are not repeated by the next page, but
+// the items beyond a full page sharing a single time are skipped.
+type TimePager[T any] struct {
+	// StartTime and EndTime bound the iteration, the last Window before now by default
+	StartTime *int64
+	EndTime   *int64
+	// Window is the longest time range of a request, no limit when 0
+	Window time.Duration
+	// Now return the current timestamp in milliseconds
+	Now func() int64
+	// Fetch return the items between start and end included, more is true when they were truncated
+	Fetch func(ctx context.Context, start, end int64) (items []T, more bool, err error)
+	Time  func(item T) int64
+	Key   func(item T) string
+}
+
+// Pages return the PageFunc of the pager
+func (p TimePager[T]) Pages() PageFunc[T] {
+	start, end := timeBounds(p.StartTime, p.EndTime, p.Window, p.Now)
+	// last is the end of the current window
+	last := start - 1
+	seen := map[string]bool{}
+	return func(ctx context.Context) ([]T, bool, error) {
+		if start > end {
+			return nil, true, nil
+		}
+		if start > last {
+			last = windowEnd(start, end, p.Window)
+		}
+		items, more, err := p.Fetch(ctx, start, last)
+		if err != nil {
+			return nil, false, err
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return p.Time(items[i]) < p.Time(items[j])
+		})
+		page := make([]T, 0, len(items))
+		for _, item := range items {
+			if !seen[p.Key(item)] {
+				page = append(page, item)
+			}
+		}
+		seen = map[string]bool{}
+		if !more || len(items) == 0 {
+			start = last + 1
+			return page, start > end, nil
+		}
+		t := p.Time(items[len(items)-1])
+		if t == start {
+			// the whole page shares the same time, skip it to make progress
+			start = t + 1
+			return page, start > end, nil
+		}
+		for _, item := range items {
+			if p.Time(item) == t {
+				seen[p.Key(item)] = true
+			}
+		}
+		start = t
+		return page, false, nil
+	}
+}
+
+// OffsetPager page an endpoint by offset inside time windows, e.g. deposits or withdrawals
+type OffsetPager[T any] struct {
+	// StartTime and EndTime bound the iteration, the last Window before now by default
+	StartTime *int64
+	EndTime   *int64
+	// Window is the longest time range of a request, no limit when 0
+	Window time.Duration
+	Limit  int
+	// Now return the current timestamp in milliseconds
+	Now func() int64
+	// Fetch return at most Limit items between start and end included from offset
+	Fetch func(ctx context.Context, start, end int64, offset int) ([]T, error)
+	// Key identify the items to drop those repeated when the pages shift
+	Key func(item T) string
+}
+
+// Pages return the PageFunc of the pager
+func (p OffsetPager[T]) Pages() PageFunc[T] {
+	start, end := timeBounds(p.StartTime, p.EndTime, p.Window, p.Now)
+	offset := 0
+	seen := map[string]bool{}
+	return func(ctx context.Context) ([]T, bool, error) {
+		if start > end {
+			return nil, true, nil
+		}
+		last := windowEnd(start, end, p.Window)
+		items, err := p.Fetch(ctx, start, last, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		page := make([]T, 0, len(items))
+		for _, item := range items {
+			if key := p.Key(item); !seen[key] {
+				seen[key] = true
+				page = append(page, item)
+			}
+		}
+		if len(items) < p.Limit {
+			start = last + 1
+			offset = 0
+			seen = map[string]bool{}
+			return page, start > end, nil
+		}
+		offset += len(items)
+		return page, false, nil
+	}
+}
@@ -0,0 +1,198 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pagedItem struct {
+	id   int64
+	time int64
+}
+
+// pagedItems return items with ids from 1 and the given times
+func pagedItems(times ...int64) []pagedItem {
+	items := make([]pagedItem, len(times))
+	for i, t := range times {
+		items[i] = pagedItem{id: int64(i + 1), time: t}
+	}
+	return items
+}
+
+func itemIDs(items []pagedItem) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.id
+	}
+	return ids
+}
+
+func TestIterator(t *testing.T) {
+	calls := 0
+	it := NewIterator(func(ctx context.Context) ([]int, bool, error) {
+		calls++
+		switch calls {
+		case 1:
+			return []int{1, 2}, false, nil
+		case 2:
+			return nil, false, nil
+		case 3:
+			return nil, false, errors.New("failed")
+		default:
+			return []int{3}, true, nil
+		}
+	})
+	ctx := context.Background()
+	items, err := it.All(ctx)
+	assert.Equal(t, []int{1, 2}, items)
+	assert.EqualError(t, err, "failed")
+
+	// the iteration resumes after an error
+	items, err = it.All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, items)
+	assert.False(t, it.Next(ctx))
+	assert.Equal(t, 4, calls)
+}
+
+func TestIDPager(t *testing.T) {
+	hour := time.Hour.Milliseconds()
+	data := pagedItems(10, 20, 5*hour, 5*hour+1, 5*hour+2, 5*hour+3, 9*hour)
+	var windows [][2]int64
+	pager := IDPager[pagedItem]{
+		Window: 2 * time.Hour,
+		Limit:  2,
+		Now:    func() int64 { return 10 * hour },
+		ByID: func(ctx context.Context, id int64) ([]pagedItem, error) {
+			var res []pagedItem
+			for _, item := range data {
+				if item.id >= id && len(res) < 2 {
+					res = append(res, item)
+				}
+			}
+			return res, nil
+		},
+		ByTime: func(ctx context.Context, start, end int64) ([]pagedItem, error) {
+			windows = append(windows, [2]int64{start, end})
+			var res []pagedItem
+			for _, item := range data {
+				if item.time >= start && item.time <= end && len(res) < 2 {
+					res = append(res, item)
+				}
+			}
+			return res, nil
+		},
+		ID:   func(item pagedItem) int64 { return item.id },
+		Time: func(item pagedItem) int64 { return item.time },
+	}
+	all := func(p IDPager[pagedItem]) []int64 {
+		items, err := NewIterator(p.Pages()).All(context.Background())
+		require.NoError(t, err)
+		return itemIDs(items)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, all(pager))
+
+	// the first item is searched by windows, then fetched by id until the end time
+	p := pager
+	start, end := hour, 5*hour+2
+	p.StartTime, p.EndTime = &start, &end
+	assert.Equal(t, []int64{3, 4, 5}, all(p))
+	assert.Equal(t, [][2]int64{{hour, 3*hour - 1}, {3 * hour, 5*hour - 1}, {5 * hour, 5*hour + 2}}, windows)
+
+	p = pager
+	fromID := int64(6)
+	p.FromID = &fromID
+	assert.Equal(t, []int64{6, 7}, all(p))
+
+	windows = nil
+	p = pager
+	start = 9*hour + 1
+	p.StartTime = &start
+	assert.Empty(t, all(p))
+	assert.Equal(t, [][2]int64{{9*hour + 1, 10 * hour}}, windows)
+}
+
+func TestTimePager(t *testing.T) {
+	day := 24 * time.Hour.Milliseconds()
+	// three items share the time 100 across the pages
+	data := pagedItems(50, 100, 100, 100, 150, 2*day+1)
+	var windows [][2]int64
+	pager := TimePager[pagedItem]{
+		Window: 24 * time.Hour,
+		Now:    func() int64 { return 3*day - 1 },
+		Fetch: func(ctx context.Context, start, end int64) ([]pagedItem, bool, error) {
+			windows = append(windows, [2]int64{start, end})
+			var res []pagedItem
+			for _, item := range data {
+				if item.time >= start && item.time <= end {
+					res = append(res, item)
+				}
+			}
+			if len(res) > 3 {
+				return res[:3], true, nil
+			}
+			return res, false, nil
+		},
+		Time: func(item pagedItem) int64 { return item.time },
+		Key:  func(item pagedItem) string { return strconv.FormatInt(item.id, 10) },
+	}
+	start := int64(0)
+	pager.StartTime = &start
+	items, err := NewIterator(pager.Pages()).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, itemIDs(items))
+	assert.Equal(t, [][2]int64{{0, day - 1}, {100, day - 1}, {101, day - 1}, {day, 2*day - 1}, {2 * day, 3*day - 1}}, windows)
+
+	// the last window before now by default
+	windows = nil
+	pager.StartTime = nil
+	items, err = NewIterator(pager.Pages()).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6}, itemIDs(items))
+	assert.Equal(t, [][2]int64{{2 * day, 3*day - 1}}, windows)
+}
+
+func TestOffsetPager(t *testing.T) {
+	day := 24 * time.Hour.Milliseconds()
+	data := pagedItems(1, 2, 3, day+1)
+	var calls [][3]int64
+	pager := OffsetPager[pagedItem]{
+		Window: 24 * time.Hour,
+		Limit:  2,
+		Now:    func() int64 { return 2*day - 1 },
+		Fetch: func(ctx context.Context, start, end int64, offset int) ([]pagedItem, error) {
+			calls = append(calls, [3]int64{start, end, int64(offset)})
+			var res []pagedItem
+			for _, item := range data {
+				if item.time >= start && item.time <= end {
+					res = append(res, item)
+				}
+			}
+			if offset == 2 {
+				// a new item shifted the page
+				offset = 1
+			}
+			if offset > len(res) {
+				offset = len(res)
+			}
+			res = res[offset:]
+			if len(res) > 2 {
+				res = res[:2]
+			}
+			return res, nil
+		},
+		Key: func(item pagedItem) string { return strconv.FormatInt(item.id, 10) },
+	}
+	start := int64(0)
+	pager.StartTime = &start
+	items, err := NewIterator(pager.Pages()).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, itemIDs(items))
+	assert.Equal(t, [][3]int64{{0, day - 1, 0}, {0, day - 1, 2}, {0, day - 1, 4}, {day, 2*day - 1, 0}}, calls)
+}
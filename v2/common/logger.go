@@ -0,0 +1,166 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Logger is a leveled structured logger, args are alternating keys and values like "endpoint", "/api/v3/order".
+// *slog.Logger implements it, see NewStdLogger for loggers without log/slog.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// LogLevel define the minimum level of the records written by NewStdLogger,
+// the values are the ones of slog.Level
+type LogLevel int
+
+// Global enums
+const (
+	LogLevelDebug LogLevel = -4
+	LogLevelInfo  LogLevel = 0
+	LogLevelWarn  LogLevel = 4
+	LogLevelError LogLevel = 8
+)
+
+// String implement fmt.Stringer
+func (l LogLevel) String() string {
+	switch {
+	case l < LogLevelInfo:
+		return "DEBUG"
+	case l < LogLevelWarn:
+		return "INFO"
+	case l < LogLevelError:
+		return "WARN"
+	}
+	return "ERROR"
+}
+
+// Log field keys
+const (
+	LogKeyRequestID  = "request_id"
+	LogKeyMethod     = "method"
+	LogKeyEndpoint   = "endpoint"
+	LogKeyWeight     = "weight"
+	LogKeyAttempt    = "attempt"
+	LogKeyStatus     = "status"
+	LogKeyLatency    = "latency"
+	LogKeyUsedWeight = "used_weight"
+	LogKeyError      = "error"
+	LogKeyBody       = "body"
+)
+
+type stdLogger struct {
+	logger *log.Logger
+	level  LogLevel
+}
+
+// NewStdLogger init a Logger writing the records of level or above as key=value pairs to logger
+func NewStdLogger(logger *log.Logger, level LogLevel) Logger {
+	return &stdLogger{logger: logger, level: level}
+}
+
+func (l *stdLogger) Debug(msg string, args ...interface{}) { l.log(LogLevelDebug, msg, args) }
+func (l *stdLogger) Info(msg string, args ...interface{})  { l.log(LogLevelInfo, msg, args) }
+func (l *stdLogger) Warn(msg string, args ...interface{})  { l.log(LogLevelWarn, msg, args) }
+func (l *stdLogger) Error(msg string, args ...interface{}) { l.log(LogLevelError, msg, args) }
+
+func (l *stdLogger) log(level LogLevel, msg string, args []interface{}) {
+	if level < l.level {
+		return
+	}
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "level=%s msg=%q", level, msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			fmt.Fprintf(b, " !BADKEY=%v", args[i])
+			break
+		}
+		v := fmt.Sprint(args[i+1])
+		if strings.ContainsAny(v, " \"=") {
+			v = fmt.Sprintf("%q", v)
+		}
+		fmt.Fprintf(b, " %v=%s", args[i], v)
+	}
+	l.logger.Print(b.String())
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(string, ...interface{}) {}
+func (discardLogger) Info(string, ...interface{})  {}
+func (discardLogger) Warn(string, ...interface{})  {}
+func (discardLogger) Error(string, ...interface{}) {}
+
+// DiscardLogger drop all the records
+var DiscardLogger Logger = discardLogger{}
+
+const redacted = "[REDACTED]"
+
+var (
+	// redactedHeaders are never written to the logs
+	redactedHeaders = []string{"X-MBX-APIKEY"}
+	redactParamRe   = regexp.MustCompile(`\b(signature|apiKey)=[^&\s"']*`)
+	redactJSONRe    = regexp.MustCompile(`"(signature|apiKey)"(\s*:\s*)"[^"]*"`)
+)
+
+// Redact hide the values of the signature and apiKey parameters in s,
+// s may be a URL, an encoded query string, a JSON payload or an error message.
+func Redact(s string) string {
+	s = redactParamRe.ReplaceAllString(s, "${1}="+redacted)
+	return redactJSONRe.ReplaceAllString(s, `"${1}"${2}"`+redacted+`"`)
+}
+
+// RedactHeader return a copy of h with the API key header hidden
+func RedactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// LogResponseBody log the body of a response at debug level, the signature and apiKey values are hidden
+func LogResponseBody(logger Logger, method, endpoint string, body []byte) {
+	logger.Debug("binance api response",
+		LogKeyMethod, method,
+		LogKeyEndpoint, endpoint,
+		LogKeyBody, Redact(string(body)),
+	)
+}
+
+// LogCall log a finished APICall, at debug level when it succeeded and at warn level when it failed
+func LogCall(logger Logger, call *APICall, res *APIResponse, err error) {
+	args := []interface{}{
+		LogKeyRequestID, call.RequestID,
+		LogKeyMethod, call.Method,
+		LogKeyEndpoint, call.Endpoint,
+		LogKeyWeight, call.Weight,
+		LogKeyAttempt, call.Attempt,
+	}
+	if q := call.Query.Encode(); q != "" {
+		args = append(args, "query", Redact(q))
+	}
+	if f := call.Form.Encode(); f != "" {
+		args = append(args, "form", Redact(f))
+	}
+	if res != nil {
+		args = append(args, LogKeyStatus, res.StatusCode, LogKeyLatency, res.Latency)
+		if w := res.Header.Get("X-Mbx-Used-Weight-1m"); w != "" {
+			args = append(args, LogKeyUsedWeight, w)
+		}
+	}
+	if err != nil {
+		logger.Warn("binance api call failed", append(args, LogKeyError, Redact(err.Error()))...)
+		return
+	}
+	logger.Debug("binance api call", args...)
+}
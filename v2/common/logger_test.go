@@ -0,0 +1,81 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLogger record the logs as lines of "LEVEL msg key=value..."
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) record(level, msg string, args []interface{}) {
+	l.lines = append(l.lines, strings.TrimSpace(fmt.Sprintln(append([]interface{}{level, msg}, args...)...)))
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.record("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.record("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.record("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.record("ERROR", msg, args) }
+
+func TestRedact(t *testing.T) {
+	for in, out := range map[string]string{
+		"https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=abcdef": "https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=[REDACTED]",
+		"apiKey=key&signature=sig":                            "apiKey=[REDACTED]&signature=[REDACTED]",
+		`{"params":{"apiKey":"key","signature": "sig"}}`:      `{"params":{"apiKey":"[REDACTED]","signature": "[REDACTED]"}}`,
+		`Get "https://x/y?signature=sig": connection refused`: `Get "https://x/y?signature=[REDACTED]": connection refused`,
+		"symbol=BTCUSDT": "symbol=BTCUSDT",
+	} {
+		assert.Equal(t, out, Redact(in))
+	}
+
+	h := http.Header{}
+	h.Set("X-MBX-APIKEY", "key")
+	h.Set("Content-Type", "application/json")
+	r := RedactHeader(h)
+	assert.Equal(t, "[REDACTED]", r.Get("X-MBX-APIKEY"))
+	assert.Equal(t, "application/json", r.Get("Content-Type"))
+	assert.Equal(t, "key", h.Get("X-MBX-APIKEY"))
+}
+
+func TestStdLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewStdLogger(log.New(buf, "", 0), LogLevelInfo)
+	l.Debug("hidden")
+	l.Info("binance api call", "endpoint", "/api/v3/order", "error", "bad request")
+	l.Error("odd", "key")
+	assert.Equal(t, "level=INFO msg=\"binance api call\" endpoint=/api/v3/order error=\"bad request\"\n"+
+		"level=ERROR msg=\"odd\" !BADKEY=key\n", buf.String())
+}
+
+func TestLogCall(t *testing.T) {
+	l := &testLogger{}
+	call := &APICall{
+		Method:    http.MethodPost,
+		Endpoint:  "/api/v3/order",
+		Query:     url.Values{"symbol": {"BTCUSDT"}},
+		Form:      url.Values{"apiKey": {"key"}},
+		Weight:    1,
+		Attempt:   2,
+		RequestID: "id",
+	}
+	res := &APIResponse{StatusCode: 200, Header: http.Header{"X-Mbx-Used-Weight-1m": {"10"}}, Latency: time.Second}
+	LogCall(l, call, res, nil)
+	LogCall(l, call, nil, errors.New(`Post "https://x/api/v3/order?signature=sig": EOF`))
+	assert.Equal(t, []string{
+		"DEBUG binance api call request_id id method POST endpoint /api/v3/order weight 1 attempt 2 " +
+			"query symbol=BTCUSDT form apiKey=[REDACTED] status 200 latency 1s used_weight 10",
+		"WARN binance api call failed request_id id method POST endpoint /api/v3/order weight 1 attempt 2 " +
+			`query symbol=BTCUSDT form apiKey=[REDACTED] error Post "https://x/api/v3/order?signature=[REDACTED]": EOF`,
+	}, l.lines)
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SecType define the security type of an endpoint
+type SecType string
+
+// Global enums
+const (
+	SecTypeNone   SecType = "NONE"
+	SecTypeAPIKey SecType = "API_KEY"
+	SecTypeSigned SecType = "SIGNED"
+)
+
+// APICall describe a single attempt of a REST request handed to the middlewares
+type APICall struct {
+	Method   string
+	Endpoint string
+	SecType  SecType
+	// Query and Form are the parsed parameters including timestamp and recvWindow but not
+	// the signature. The request is already signed, changing them has no effect.
+	Query url.Values
+	Form  url.Values
+	// Header is sent with the request, middlewares may add headers e.g. to tag requests
+	Header http.Header
+	Weight int64
+	// Attempt is the number of the attempt, starting at 1, see RetryPolicy
+	Attempt int
+	// RequestID is generated by the client for every request and kept across its attempts,
+	// it is the request_id field of the logs
+	RequestID string
+}
+
+// APIResponse describe the response of an APICall
+type APIResponse struct {
+	StatusCode int // 0 when no response was received
+	Header     http.Header
+	Body       []byte
+	// Latency is the time from sending the request to reading the whole response
+	Latency time.Duration
+}
+
+// CallHandler send an APICall. The error is a *APIError when the server returned status 4xx or 5xx,
+// the response may be non nil together with an error.
+type CallHandler func(ctx context.Context, call *APICall) (*APIResponse, error)
+
+// Middleware wrap a CallHandler, e.g. to record metrics, audit calls, tag requests,
+// break circuits or inject faults. It may return without calling next.
+type Middleware func(next CallHandler) CallHandler
+
+// ChainMiddleware wrap h with the middlewares, the first middleware is the outermost one
+func ChainMiddleware(h CallHandler, middlewares ...Middleware) CallHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// WsServeFunc open the websocket stream of endpoint, handler is called with every message
+// until stopC is closed or the connection fails
+type WsServeFunc func(endpoint string, handler func(message []byte), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// WsMiddleware wrap the opening of the websocket streams, e.g. to record or replay them.
+// It may return without calling next.
+type WsMiddleware func(next WsServeFunc) WsServeFunc
+
+// ChainWsMiddleware wrap serve with the middlewares, the first middleware is the outermost one
+func ChainWsMiddleware(serve WsServeFunc, middlewares ...WsMiddleware) WsServeFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		serve = middlewares[i](serve)
+	}
+	return serve
+}
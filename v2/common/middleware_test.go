@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainMiddleware(t *testing.T) {
+	var order []string
+	mw := func(name string) Middleware {
+		return func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *APICall) (*APIResponse, error) {
+				order = append(order, name)
+				return next(ctx, call)
+			}
+		}
+	}
+	h := ChainMiddleware(func(ctx context.Context, call *APICall) (*APIResponse, error) {
+		order = append(order, "handler")
+		return &APIResponse{StatusCode: 200}, nil
+	}, mw("first"), mw("second"))
+
+	res, err := h(context.Background(), &APICall{})
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, []string{"first", "second", "handler"}, order)
+}
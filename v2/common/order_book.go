@@ -0,0 +1,556 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrOrderBookGap is wrapped by the errors of the updates which do not follow the book,
+	// the book is then synced again from a new snapshot
+	ErrOrderBookGap = errors.New("order book gap")
+	// ErrOrderBookClosed is returned by WaitSynced when the book is closed
+	ErrOrderBookClosed = errors.New("order book closed")
+
+	errDepthStreamClosed = errors.New("depth stream closed")
+	errOrderBookOverflow = fmt.Errorf("%w: updates dropped by the full buffer", ErrOrderBookGap)
+)
+
+// BookSide define a side of an order book
+type BookSide string
+
+// Book sides
+const (
+	BookSideBid BookSide = "BID"
+	BookSideAsk BookSide = "ASK"
+)
+
+// BookState define the state of a local order book
+type BookState string
+
+// Book states
+const (
+	// BookStateSyncing is the state of a book waiting for its first snapshot
+	BookStateSyncing BookState = "SYNCING"
+	// BookStateSynced is the state of a book following the updates of the stream
+	BookStateSynced BookState = "SYNCED"
+	// BookStateOutOfSync is the state of a book recovering from a gap or a lost stream,
+	// it keeps its levels until the new snapshot
+	BookStateOutOfSync BookState = "OUT_OF_SYNC"
+	// BookStateClosed is the state of a book after Close or the end of the context of Start
+	BookStateClosed BookState = "CLOSED"
+)
+
+// DepthRules define how the updates of a diff. depth stream follow each other
+type DepthRules int
+
+// Depth rules
+const (
+	// SpotDepthRules: an update starts right after the previous one, U is the previous u + 1
+	SpotDepthRules DepthRules = iota
+	// FuturesDepthRules: an update references the previous one, pu is the previous u
+	FuturesDepthRules
+)
+
+// BookLevel define a price level of an order book
+type BookLevel struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// DepthSnapshot define the levels of an order book at LastUpdateID
+type DepthSnapshot struct {
+	LastUpdateID int64
+	Bids         []PriceLevel
+	Asks         []PriceLevel
+}
+
+// DepthUpdate define an event of a diff. depth stream, a level with a zero quantity is removed
+type DepthUpdate struct {
+	FirstUpdateID int64
+	LastUpdateID  int64
+	// PrevLastUpdateID is the LastUpdateID of the previous update, futures only
+	PrevLastUpdateID int64
+	Bids             []PriceLevel
+	Asks             []PriceLevel
+}
+
+// OrderBookConfig define the sources of an OrderBook, the product packages fill Snapshot, Stream and Rules
+type OrderBookConfig struct {
+	// Snapshot fetch the levels of the book, e.g. with a DepthService
+	Snapshot func(ctx context.Context) (*DepthSnapshot, error)
+	// Stream open the diff. depth stream of the book
+	Stream func(handler func(update *DepthUpdate), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+	Rules  DepthRules
+	// RetryDelay is the delay before fetching a snapshot or opening the stream again after an error, 1s by default.
+	// A gap is recovered right away, unless the previous sync also ended on a gap.
+	RetryDelay time.Duration
+	// OnState is called on every change of state, err is the cause of the BookStateOutOfSync states
+	OnState func(state BookState, err error)
+	// OnUpdate is called after every update applied to the book
+	OnUpdate func()
+	// ErrHandler receive the errors of the stream
+	ErrHandler func(err error)
+}
+
+// OrderBook maintain a local order book from a depth snapshot and the updates of a diff. depth stream.
+// The updates received while the snapshot is fetched are buffered, and the book is synced again from a
+// new snapshot when an update does not follow the previous one. It is safe for concurrent readers.
+//
+// The stream never waits for the book: the updates which do not fit in the buffer are dropped, and the
+// book is synced again from a new snapshot.
+type OrderBook struct {
+	cfg     OrderBookConfig
+	updates chan *DepthUpdate
+	// overflow is signaled when an update was dropped because updates was full
+	overflow  chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu           sync.RWMutex
+	state        BookState
+	synced       chan struct{} // closed while the book is synced
+	lastUpdateID int64
+	bids         []BookLevel // by descending price
+	asks         []BookLevel // by ascending price
+}
+
+// NewOrderBook init an empty OrderBook, see Start
+func NewOrderBook(cfg OrderBookConfig) *OrderBook {
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = time.Second
+	}
+	return &OrderBook{
+		cfg:      cfg,
+		updates:  make(chan *DepthUpdate, 1000),
+		overflow: make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		state:    BookStateSyncing,
+		synced:   make(chan struct{}),
+	}
+}
+
+// Start open the depth stream and sync the book in the background until ctx is done or Close is called
+func (b *OrderBook) Start(ctx context.Context) error {
+	doneC, stopC, err := b.openStream()
+	if err != nil {
+		return err
+	}
+	go b.run(ctx, doneC, stopC)
+	return nil
+}
+
+// Close stop the stream of the book
+func (b *OrderBook) Close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+// WaitSynced wait until the book is synced
+func (b *OrderBook) WaitSynced(ctx context.Context) error {
+	b.mu.RLock()
+	state, synced := b.state, b.synced
+	b.mu.RUnlock()
+	if state == BookStateClosed {
+		return ErrOrderBookClosed
+	}
+	select {
+	case <-synced:
+		return nil
+	case <-b.done:
+		return ErrOrderBookClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *OrderBook) openStream() (doneC, stopC chan struct{}, err error) {
+	return b.cfg.Stream(func(update *DepthUpdate) {
+		select {
+		case b.updates <- update:
+		default:
+			select {
+			case b.overflow <- struct{}{}:
+			default:
+			}
+		}
+	}, b.handleErr)
+}
+
+func (b *OrderBook) handleErr(err error) {
+	if b.cfg.ErrHandler != nil {
+		b.cfg.ErrHandler(err)
+	}
+}
+
+// run sync the book until it is closed, the stream is opened again when it ends
+func (b *OrderBook) run(ctx context.Context, doneC, stopC chan struct{}) {
+	defer func() {
+		close(stopC)
+		b.Close()
+		b.setState(BookStateClosed, nil)
+	}()
+	// retried is true after a gap recovered right away, a gap coming back then waits for RetryDelay
+	// so that a lagging snapshot or a slow consumer does not fetch snapshots in a loop
+	retried := false
+	for {
+		err := b.sync(ctx, doneC)
+		if b.isDone(ctx) {
+			return
+		}
+		b.setState(BookStateOutOfSync, err)
+		if errors.Is(err, ErrOrderBookGap) && !retried {
+			retried = true
+			continue
+		}
+		retried = false
+		if !b.sleep(ctx) {
+			return
+		}
+		if !errors.Is(err, errDepthStreamClosed) {
+			continue
+		}
+		for {
+			var openErr error
+			if doneC, stopC, openErr = b.openStream(); openErr == nil {
+				break
+			}
+			b.handleErr(openErr)
+			if !b.sleep(ctx) {
+				// stopC of the ended stream is closed by the deferred function
+				return
+			}
+		}
+	}
+}
+
+func (b *OrderBook) isDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep wait for RetryDelay, it returns false when the book is done
+func (b *OrderBook) sleep(ctx context.Context) bool {
+	timer := time.NewTimer(b.cfg.RetryDelay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-b.done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+type snapshotResult struct {
+	snapshot *DepthSnapshot
+	err      error
+}
+
+// sync fetch a snapshot while buffering the updates, then apply the updates until an error occurs
+func (b *OrderBook) sync(ctx context.Context, doneC chan struct{}) error {
+	// the updates dropped so far are older than the snapshot
+	select {
+	case <-b.overflow:
+	default:
+	}
+	result := make(chan snapshotResult, 1)
+	go func() {
+		snapshot, err := b.cfg.Snapshot(ctx)
+		result <- snapshotResult{snapshot: snapshot, err: err}
+	}()
+	var buffered []*DepthUpdate
+	var snapshot *DepthSnapshot
+	for snapshot == nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.done:
+			return ErrOrderBookClosed
+		case <-doneC:
+			return errDepthStreamClosed
+		case <-b.overflow:
+			return errOrderBookOverflow
+		case update := <-b.updates:
+			buffered = append(buffered, update)
+		case res := <-result:
+			if res.err != nil {
+				return res.err
+			}
+			snapshot = res.snapshot
+		}
+	}
+	if err := b.reset(snapshot); err != nil {
+		return err
+	}
+	first := true
+	for _, update := range buffered {
+		if err := b.apply(update, &first); err != nil {
+			return err
+		}
+	}
+	b.setState(BookStateSynced, nil)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.done:
+			return ErrOrderBookClosed
+		case <-doneC:
+			return errDepthStreamClosed
+		case <-b.overflow:
+			return errOrderBookOverflow
+		case update := <-b.updates:
+			if err := b.apply(update, &first); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// reset replace the levels of the book by the ones of snapshot
+func (b *OrderBook) reset(snapshot *DepthSnapshot) error {
+	bids, err := parseBookLevels(snapshot.Bids)
+	if err != nil {
+		return err
+	}
+	asks, err := parseBookLevels(snapshot.Asks)
+	if err != nil {
+		return err
+	}
+	sort.Slice(bids, func(i, j int) bool { return bids[i].Price.GreaterThan(bids[j].Price) })
+	sort.Slice(asks, func(i, j int) bool { return asks[i].Price.LessThan(asks[j].Price) })
+	b.mu.Lock()
+	b.lastUpdateID = snapshot.LastUpdateID
+	b.bids, b.asks = bids, asks
+	b.mu.Unlock()
+	return nil
+}
+
+func parseBookLevels(levels []PriceLevel) ([]BookLevel, error) {
+	res := make([]BookLevel, 0, len(levels))
+	for i := range levels {
+		price, quantity, err := levels[i].ParseDecimal()
+		if err != nil {
+			return nil, err
+		}
+		if !quantity.IsZero() {
+			res = append(res, BookLevel{Price: price, Quantity: quantity})
+		}
+	}
+	return res, nil
+}
+
+// apply check that update follows the book and apply it, the updates older than the book are skipped.
+// first is true until an update was applied after the snapshot.
+func (b *OrderBook) apply(update *DepthUpdate, first *bool) error {
+	b.mu.Lock()
+	last := b.lastUpdateID
+	switch b.cfg.Rules {
+	case FuturesDepthRules:
+		if update.LastUpdateID < last {
+			b.mu.Unlock()
+			return nil
+		}
+		if *first && update.FirstUpdateID > last {
+			b.mu.Unlock()
+			return fmt.Errorf("%w: update %d-%d does not cover the snapshot %d", ErrOrderBookGap, update.FirstUpdateID, update.LastUpdateID, last)
+		}
+		if !*first && update.PrevLastUpdateID != last {
+			b.mu.Unlock()
+			return fmt.Errorf("%w: update %d follows %d instead of %d", ErrOrderBookGap, update.LastUpdateID, update.PrevLastUpdateID, last)
+		}
+	default:
+		if update.LastUpdateID <= last {
+			b.mu.Unlock()
+			return nil
+		}
+		if *first && update.FirstUpdateID > last+1 || !*first && update.FirstUpdateID != last+1 {
+			b.mu.Unlock()
+			return fmt.Errorf("%w: update %d-%d does not follow %d", ErrOrderBookGap, update.FirstUpdateID, update.LastUpdateID, last)
+		}
+	}
+	bids, asks := b.bids, b.asks
+	for i := range update.Bids {
+		price, quantity, err := update.Bids[i].ParseDecimal()
+		if err != nil {
+			b.mu.Unlock()
+			return err
+		}
+		bids = setBookLevel(bids, price, quantity, true)
+	}
+	for i := range update.Asks {
+		price, quantity, err := update.Asks[i].ParseDecimal()
+		if err != nil {
+			b.mu.Unlock()
+			return err
+		}
+		asks = setBookLevel(asks, price, quantity, false)
+	}
+	b.bids, b.asks = bids, asks
+	b.lastUpdateID = update.LastUpdateID
+	b.mu.Unlock()
+	*first = false
+	if b.cfg.OnUpdate != nil {
+		b.cfg.OnUpdate()
+	}
+	return nil
+}
+
+// setBookLevel set the quantity of a price in levels ordered by descending prices when desc is true,
+// a zero quantity removes the price
+func setBookLevel(levels []BookLevel, price, quantity decimal.Decimal, desc bool) []BookLevel {
+	i := sort.Search(len(levels), func(i int) bool {
+		if desc {
+			return levels[i].Price.LessThanOrEqual(price)
+		}
+		return levels[i].Price.GreaterThanOrEqual(price)
+	})
+	found := i < len(levels) && levels[i].Price.Equal(price)
+	switch {
+	case quantity.IsZero() && found:
+		return append(levels[:i], levels[i+1:]...)
+	case quantity.IsZero():
+		return levels
+	case found:
+		levels[i].Quantity = quantity
+		return levels
+	}
+	levels = append(levels, BookLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = BookLevel{Price: price, Quantity: quantity}
+	return levels
+}
+
+// setState change the state of the book and call OnState
+func (b *OrderBook) setState(state BookState, err error) {
+	b.mu.Lock()
+	prev := b.state
+	if prev == BookStateClosed || prev == state && err == nil {
+		b.mu.Unlock()
+		return
+	}
+	b.state = state
+	if state == BookStateSynced {
+		close(b.synced)
+	} else if prev == BookStateSynced {
+		b.synced = make(chan struct{})
+	}
+	b.mu.Unlock()
+	if b.cfg.OnState != nil {
+		b.cfg.OnState(state, err)
+	}
+}
+
+// State return the state of the book
+func (b *OrderBook) State() BookState {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.state
+}
+
+// LastUpdateID return the id of the last update applied to the book
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// levels return the levels of a side, mu must be locked
+func (b *OrderBook) levels(side BookSide) []BookLevel {
+	if side == BookSideBid {
+		return b.bids
+	}
+	return b.asks
+}
+
+// BestBid return the highest bid, ok is false when there is none
+func (b *OrderBook) BestBid() (level BookLevel, ok bool) {
+	return b.best(BookSideBid)
+}
+
+// BestAsk return the lowest ask, ok is false when there is none
+func (b *OrderBook) BestAsk() (level BookLevel, ok bool) {
+	return b.best(BookSideAsk)
+}
+
+func (b *OrderBook) best(side BookSide) (BookLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	levels := b.levels(side)
+	if len(levels) == 0 {
+		return BookLevel{}, false
+	}
+	return levels[0], true
+}
+
+// Levels return the n best levels of a side, all of them when n <= 0
+func (b *OrderBook) Levels(side BookSide, n int) []BookLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	levels := b.levels(side)
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	return append([]BookLevel(nil), levels[:n]...)
+}
+
+// QuantityAt return the quantity of a price level, zero when there is none
+func (b *OrderBook) QuantityAt(side BookSide, price decimal.Decimal) decimal.Decimal {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, level := range b.levels(side) {
+		if level.Price.Equal(price) {
+			return level.Quantity
+		}
+	}
+	return decimal.Zero
+}
+
+// DepthTo return the total quantity of the levels of a side at price or better
+func (b *OrderBook) DepthTo(side BookSide, price decimal.Decimal) decimal.Decimal {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	total := decimal.Zero
+	for _, level := range b.levels(side) {
+		if side == BookSideBid && level.Price.LessThan(price) || side == BookSideAsk && level.Price.GreaterThan(price) {
+			break
+		}
+		total = total.Add(level.Quantity)
+	}
+	return total
+}
+
+// VWAP return the average price of size taken from the best levels of a side, e.g. the asks for a buy,
+// ok is false when the side holds less than size
+func (b *OrderBook) VWAP(side BookSide, size decimal.Decimal) (price decimal.Decimal, ok bool) {
+	if size.Sign() <= 0 {
+		return decimal.Zero, false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	remaining, notional := size, decimal.Zero
+	for _, level := range b.levels(side) {
+		quantity := decimal.Min(level.Quantity, remaining)
+		notional = notional.Add(quantity.Mul(level.Price))
+		remaining = remaining.Sub(quantity)
+		if remaining.Sign() == 0 {
+			return notional.Div(size), true
+		}
+	}
+	return decimal.Zero, false
+}
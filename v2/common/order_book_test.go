@@ -0,0 +1,305 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDepth serve the snapshots sent on its channel and a stream fed by send
+type fakeDepth struct {
+	snapshots chan *DepthSnapshot
+	states    chan BookState
+
+	mu      sync.Mutex
+	handler func(update *DepthUpdate)
+	doneC   chan struct{}
+	streams int
+}
+
+func newFakeDepth() *fakeDepth {
+	return &fakeDepth{snapshots: make(chan *DepthSnapshot, 1), states: make(chan BookState, 10)}
+}
+
+func (f *fakeDepth) config(rules DepthRules) OrderBookConfig {
+	return OrderBookConfig{
+		Rules:      rules,
+		RetryDelay: time.Millisecond,
+		Snapshot: func(ctx context.Context) (*DepthSnapshot, error) {
+			select {
+			case s := <-f.snapshots:
+				return s, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+		Stream: func(handler func(update *DepthUpdate), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.handler = handler
+			f.doneC = make(chan struct{})
+			f.streams++
+			return f.doneC, make(chan struct{}), nil
+		},
+		OnState: func(state BookState, err error) {
+			f.states <- state
+		},
+	}
+}
+
+func (f *fakeDepth) send(update *DepthUpdate) {
+	f.mu.Lock()
+	handler := f.handler
+	f.mu.Unlock()
+	handler(update)
+}
+
+func (f *fakeDepth) nextState(t *testing.T) BookState {
+	select {
+	case state := <-f.states:
+		return state
+	case <-time.After(5 * time.Second):
+		t.Fatal("no state")
+		return ""
+	}
+}
+
+func levels(prices ...string) []PriceLevel {
+	var res []PriceLevel
+	for i := 0; i < len(prices); i += 2 {
+		res = append(res, PriceLevel{Price: prices[i], Quantity: prices[i+1]})
+	}
+	return res
+}
+
+func TestOrderBookSpot(t *testing.T) {
+	f := newFakeDepth()
+	b := NewOrderBook(f.config(SpotDepthRules))
+	require.NoError(t, b.Start(context.Background()))
+	defer b.Close()
+	assert.Equal(t, BookStateSyncing, b.State())
+
+	// buffered while the snapshot is fetched
+	f.send(&DepthUpdate{FirstUpdateID: 90, LastUpdateID: 95, Bids: levels("1.0", "99")})
+	f.send(&DepthUpdate{FirstUpdateID: 96, LastUpdateID: 101, Bids: levels("1.5", "0", "1.4", "3"), Asks: levels("2.0", "1")})
+	f.snapshots <- &DepthSnapshot{
+		LastUpdateID: 100,
+		Bids:         levels("1.5", "2", "1.2", "4"),
+		Asks:         levels("1.8", "1", "1.6", "2"),
+	}
+	require.NoError(t, b.WaitSynced(context.Background()))
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.send(&DepthUpdate{FirstUpdateID: 102, LastUpdateID: 103, Asks: levels("1.6", "0.5")})
+	assert.Eventually(t, func() bool { return b.LastUpdateID() == 103 }, 5*time.Second, time.Millisecond)
+
+	best, ok := b.BestBid()
+	require.True(t, ok)
+	assert.Equal(t, "1.4", best.Price.String())
+	best, ok = b.BestAsk()
+	require.True(t, ok)
+	assert.Equal(t, "1.6", best.Price.String())
+	assert.Equal(t, []BookLevel{
+		{Price: decimal.RequireFromString("1.4"), Quantity: decimal.RequireFromString("3")},
+		{Price: decimal.RequireFromString("1.2"), Quantity: decimal.RequireFromString("4")},
+	}, b.Levels(BookSideBid, 0))
+	assert.Len(t, b.Levels(BookSideAsk, 2), 2)
+	assert.Equal(t, "1", b.QuantityAt(BookSideAsk, decimal.RequireFromString("1.8")).String())
+	assert.True(t, b.QuantityAt(BookSideAsk, decimal.RequireFromString("1.7")).IsZero())
+	assert.Equal(t, "1.5", b.DepthTo(BookSideAsk, decimal.RequireFromString("1.8")).String())
+	assert.Equal(t, "3", b.DepthTo(BookSideBid, decimal.RequireFromString("1.3")).String())
+
+	// 0.5 at 1.6 and 1 at 1.8
+	vwap, ok := b.VWAP(BookSideAsk, decimal.RequireFromString("1.5"))
+	require.True(t, ok)
+	assert.Equal(t, "1.7333333333333333", vwap.String())
+	_, ok = b.VWAP(BookSideAsk, decimal.RequireFromString("4"))
+	assert.False(t, ok)
+}
+
+func TestOrderBookGap(t *testing.T) {
+	f := newFakeDepth()
+	b := NewOrderBook(f.config(SpotDepthRules))
+	require.NoError(t, b.Start(context.Background()))
+	defer b.Close()
+
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 10, Bids: levels("1", "1")}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.send(&DepthUpdate{FirstUpdateID: 11, LastUpdateID: 12})
+	f.send(&DepthUpdate{FirstUpdateID: 14, LastUpdateID: 15})
+	assert.Equal(t, BookStateOutOfSync, f.nextState(t))
+	// the levels are kept until the new snapshot
+	_, ok := b.BestBid()
+	assert.True(t, ok)
+
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 20, Bids: levels("2", "1")}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	best, _ := b.BestBid()
+	assert.Equal(t, "2", best.Price.String())
+}
+
+func TestOrderBookFutures(t *testing.T) {
+	f := newFakeDepth()
+	errs := make(chan error, 1)
+	cfg := f.config(FuturesDepthRules)
+	cfg.OnState = func(state BookState, err error) {
+		if state == BookStateOutOfSync {
+			errs <- err
+		}
+		f.states <- state
+	}
+	b := NewOrderBook(cfg)
+	require.NoError(t, b.Start(context.Background()))
+	defer b.Close()
+
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 100, Asks: levels("5", "1")}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.send(&DepthUpdate{FirstUpdateID: 90, LastUpdateID: 99, PrevLastUpdateID: 89})
+	f.send(&DepthUpdate{FirstUpdateID: 95, LastUpdateID: 105, PrevLastUpdateID: 94, Asks: levels("5", "2")})
+	f.send(&DepthUpdate{FirstUpdateID: 106, LastUpdateID: 110, PrevLastUpdateID: 105})
+	assert.Eventually(t, func() bool { return b.LastUpdateID() == 110 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, "2", b.QuantityAt(BookSideAsk, decimal.NewFromInt(5)).String())
+
+	f.send(&DepthUpdate{FirstUpdateID: 112, LastUpdateID: 115, PrevLastUpdateID: 111})
+	assert.Equal(t, BookStateOutOfSync, f.nextState(t))
+	assert.True(t, errors.Is(<-errs, ErrOrderBookGap))
+}
+
+func TestOrderBookStreamClosed(t *testing.T) {
+	f := newFakeDepth()
+	b := NewOrderBook(f.config(SpotDepthRules))
+	require.NoError(t, b.Start(context.Background()))
+
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 1}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.mu.Lock()
+	close(f.doneC)
+	f.mu.Unlock()
+	assert.Equal(t, BookStateOutOfSync, f.nextState(t))
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 2}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.mu.Lock()
+	assert.Equal(t, 2, f.streams)
+	f.mu.Unlock()
+
+	b.Close()
+	assert.Equal(t, BookStateClosed, f.nextState(t))
+	assert.Equal(t, ErrOrderBookClosed, b.WaitSynced(context.Background()))
+}
+
+func TestOrderBookContext(t *testing.T) {
+	f := newFakeDepth()
+	b := NewOrderBook(f.config(SpotDepthRules))
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, b.Start(ctx))
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	assert.Equal(t, context.DeadlineExceeded, b.WaitSynced(waitCtx))
+	cancel()
+	assert.Equal(t, BookStateClosed, f.nextState(t))
+}
+
+func TestOrderBookOverflow(t *testing.T) {
+	f := newFakeDepth()
+	errs := make(chan error, 1)
+	release := make(chan struct{})
+	cfg := f.config(SpotDepthRules)
+	cfg.OnState = func(state BookState, err error) {
+		if state == BookStateOutOfSync {
+			errs <- err
+		}
+		f.states <- state
+	}
+	// the first update blocks the book until release
+	var once sync.Once
+	cfg.OnUpdate = func() {
+		once.Do(func() { <-release })
+	}
+	b := NewOrderBook(cfg)
+	require.NoError(t, b.Start(context.Background()))
+	defer b.Close()
+
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 0}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for i := int64(1); i <= 1100; i++ {
+			f.send(&DepthUpdate{FirstUpdateID: i, LastUpdateID: i})
+		}
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream waited for the book")
+	}
+	close(release)
+	assert.Equal(t, BookStateOutOfSync, f.nextState(t))
+	assert.ErrorIs(t, <-errs, ErrOrderBookGap)
+
+	// the queued updates older than the new snapshot are skipped
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 1100, Bids: levels("1", "1")}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+	f.send(&DepthUpdate{FirstUpdateID: 1101, LastUpdateID: 1101})
+	assert.Eventually(t, func() bool { return b.LastUpdateID() == 1101 }, 5*time.Second, time.Millisecond)
+}
+
+func TestOrderBookOverflowWhileRetrying(t *testing.T) {
+	f := newFakeDepth()
+	cfg := f.config(SpotDepthRules)
+	cfg.RetryDelay = 100 * time.Millisecond
+	snapshot := cfg.Snapshot
+	failed := make(chan struct{})
+	cfg.Snapshot = func(ctx context.Context) (*DepthSnapshot, error) {
+		select {
+		case <-failed:
+			return snapshot(ctx)
+		default:
+			close(failed)
+			return nil, errors.New("snapshot failed")
+		}
+	}
+	b := NewOrderBook(cfg)
+	require.NoError(t, b.Start(context.Background()))
+	defer b.Close()
+
+	// the book waits for RetryDelay, the stream drops the updates instead of waiting
+	assert.Equal(t, BookStateOutOfSync, f.nextState(t))
+	for i := int64(1); i <= 1100; i++ {
+		f.send(&DepthUpdate{FirstUpdateID: i, LastUpdateID: i})
+	}
+	f.snapshots <- &DepthSnapshot{LastUpdateID: 1100}
+	assert.Equal(t, BookStateSynced, f.nextState(t))
+}
+
+func TestOrderBookGapBackoff(t *testing.T) {
+	f := newFakeDepth()
+	cfg := f.config(SpotDepthRules)
+	cfg.RetryDelay = 50 * time.Millisecond
+	cfg.OnState = nil
+	var mu sync.Mutex
+	snapshots := 0
+	cfg.Snapshot = func(ctx context.Context) (*DepthSnapshot, error) {
+		mu.Lock()
+		snapshots++
+		mu.Unlock()
+		// every snapshot is followed by an update which does not follow it
+		f.send(&DepthUpdate{FirstUpdateID: 100, LastUpdateID: 101})
+		return &DepthSnapshot{LastUpdateID: 10}, nil
+	}
+	b := NewOrderBook(cfg)
+	require.NoError(t, b.Start(context.Background()))
+
+	time.Sleep(500 * time.Millisecond)
+	b.Close()
+	mu.Lock()
+	defer mu.Unlock()
+	// two snapshots per RetryDelay at most, one after the delay and one right away
+	assert.LessOrEqual(t, snapshots, 2*(500/50)+2)
+	assert.Greater(t, snapshots, 2)
+}
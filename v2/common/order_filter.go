@@ -0,0 +1,107 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode define how the prices and quantities are rounded to the tick and step sizes of a symbol
+type RoundingMode string
+
+// Rounding modes, the values which are not on a step are rejected with RoundingNone
+const (
+	RoundingNone    RoundingMode = ""
+	RoundingDown    RoundingMode = "DOWN"
+	RoundingUp      RoundingMode = "UP"
+	RoundingNearest RoundingMode = "NEAREST"
+)
+
+// RoundStep round value to base plus a multiple of step, ok is false when value is not on a step
+// and mode is RoundingNone. A zero step disables the rounding.
+func RoundStep(value, base, step decimal.Decimal, mode RoundingMode) (rounded decimal.Decimal, ok bool) {
+	if step.Sign() <= 0 || value.Sub(base).Mod(step).IsZero() {
+		return value, true
+	}
+	steps := value.Sub(base).Div(step)
+	switch mode {
+	case RoundingDown:
+		steps = steps.Floor()
+	case RoundingUp:
+		steps = steps.Ceil()
+	case RoundingNearest:
+		steps = steps.Round(0)
+	default:
+		return value, false
+	}
+	return base.Add(steps.Mul(step)), true
+}
+
+// OrderFilterError define an order rejected locally by a filter of its symbol before it was sent
+type OrderFilterError struct {
+	Symbol string
+	// Filter is the filter type, e.g. PRICE_FILTER or LOT_SIZE
+	Filter string
+	// Field is the rejected parameter, e.g. price or quantity
+	Field   string
+	Message string
+}
+
+// Error return the symbol, filter and reason of the rejection
+func (e *OrderFilterError) Error() string {
+	return fmt.Sprintf("<OrderFilterError> symbol=%s, filter=%s, field=%s, msg=%s", e.Symbol, e.Filter, e.Field, e.Message)
+}
+
+// IsOrderFilterError check if err is an order rejected locally by a filter
+func IsOrderFilterError(err error) bool {
+	var filterErr *OrderFilterError
+	return errors.As(err, &filterErr)
+}
+
+// OrderCheck check the fields of an order of a symbol against its filters
+type OrderCheck struct {
+	Symbol   string
+	Rounding RoundingMode
+}
+
+// Errorf return an OrderFilterError of the symbol
+func (c OrderCheck) Errorf(filter, field, format string, args ...interface{}) error {
+	return &OrderFilterError{Symbol: c.Symbol, Filter: filter, Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// Decimal parse a field of the order, nil when it is not set
+func (c OrderCheck) Decimal(field string, value *string) (*decimal.Decimal, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(*value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q of %s: %w", field, *value, c.Symbol, err)
+	}
+	return &d, nil
+}
+
+// Step round the field to min plus a multiple of step and check it is between min and max,
+// the rounded value is written back. Empty or zero bounds are not checked.
+func (c OrderCheck) Step(filter, field string, value *string, min, max, step string) error {
+	d, err := c.Decimal(field, value)
+	if d == nil || err != nil {
+		return err
+	}
+	minDec, maxDec, stepDec := ToDecimal(min), ToDecimal(max), ToDecimal(step)
+	rounded, ok := RoundStep(*d, minDec, stepDec, c.Rounding)
+	if !ok {
+		return c.Errorf(filter, field, "%s %s is not on a step of %s", field, *value, stepDec)
+	}
+	if minDec.Sign() > 0 && rounded.LessThan(minDec) {
+		return c.Errorf(filter, field, "%s %s is below the minimum %s", field, rounded, minDec)
+	}
+	if maxDec.Sign() > 0 && rounded.GreaterThan(maxDec) {
+		return c.Errorf(filter, field, "%s %s is above the maximum %s", field, rounded, maxDec)
+	}
+	if !rounded.Equal(*d) {
+		*value = rounded.String()
+	}
+	return nil
+}
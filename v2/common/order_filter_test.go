@@ -0,0 +1,67 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundStep(t *testing.T) {
+	d := decimal.RequireFromString
+	for _, tt := range []struct {
+		value, base, step string
+		mode              RoundingMode
+		rounded           string
+		ok                bool
+	}{
+		{"1.23", "0", "0.01", RoundingNone, "1.23", true},
+		{"1.234", "0", "0.01", RoundingNone, "1.234", false},
+		{"1.234", "0", "0.01", RoundingDown, "1.23", true},
+		{"1.234", "0", "0.01", RoundingUp, "1.24", true},
+		{"1.236", "0", "0.01", RoundingNearest, "1.24", true},
+		// the steps start from the minimum
+		{"1.2", "0.05", "0.1", RoundingDown, "1.15", true},
+		{"1.234", "0", "0", RoundingNone, "1.234", true},
+	} {
+		rounded, ok := RoundStep(d(tt.value), d(tt.base), d(tt.step), tt.mode)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.Equal(t, tt.rounded, rounded.String(), tt.value)
+	}
+}
+
+func TestOrderCheckStep(t *testing.T) {
+	check := OrderCheck{Symbol: "BTCUSDT", Rounding: RoundingDown}
+	price := "30000.019"
+	assert.NoError(t, check.Step("PRICE_FILTER", "price", &price, "0.01", "100000", "0.01"))
+	assert.Equal(t, "30000.01", price)
+
+	quantity := "0.000019"
+	err := check.Step("LOT_SIZE", "quantity", &quantity, "0.00001", "9000", "0.00001")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.00001", quantity)
+
+	quantity = "0.000009"
+	err = check.Step("LOT_SIZE", "quantity", &quantity, "0.00001", "9000", "0.00001")
+	assert.EqualError(t, err, "<OrderFilterError> symbol=BTCUSDT, filter=LOT_SIZE, field=quantity, msg=quantity 0 is below the minimum 0.00001")
+	assert.True(t, IsOrderFilterError(err))
+	assert.False(t, IsAPIError(err))
+
+	check.Rounding = RoundingNone
+	price = "30000.019"
+	err = check.Step("PRICE_FILTER", "price", &price, "0.01", "100000", "0.01")
+	assert.EqualError(t, err, "<OrderFilterError> symbol=BTCUSDT, filter=PRICE_FILTER, field=price, msg=price 30000.019 is not on a step of 0.01")
+	assert.Equal(t, "30000.019", price)
+
+	price = "200000"
+	err = check.Step("PRICE_FILTER", "price", &price, "0.01", "100000", "0.01")
+	assert.EqualError(t, err, "<OrderFilterError> symbol=BTCUSDT, filter=PRICE_FILTER, field=price, msg=price 200000 is above the maximum 100000")
+
+	// unset fields and empty bounds are not checked
+	assert.NoError(t, check.Step("PRICE_FILTER", "price", nil, "0.01", "100000", "0.01"))
+	price = "0.001"
+	assert.NoError(t, check.Step("MARKET_LOT_SIZE", "quantity", &price, "0", "0", "0"))
+
+	price = "abc"
+	assert.Error(t, check.Step("PRICE_FILTER", "price", &price, "0.01", "100000", "0.01"))
+}
@@ -1,6 +1,10 @@
 package common
 
-import "strconv"
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
 
 // PriceLevel is a common structure for bids and asks in the
 // order book.
@@ -23,3 +27,27 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	}
 	return price, quantity, nil
 }
+
+// ParseDecimal parses this PriceLevel's Price and Quantity as decimals,
+// without the rounding errors of Parse.
+func (p *PriceLevel) ParseDecimal() (decimal.Decimal, decimal.Decimal, error) {
+	price, err := ParseDecimal(p.Price)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	quantity, err := ParseDecimal(p.Quantity)
+	if err != nil {
+		return price, decimal.Zero, err
+	}
+	return price, quantity, nil
+}
+
+// PriceDecimal parse Price as a decimal, see ParseDecimal
+func (p *PriceLevel) PriceDecimal() (decimal.Decimal, error) {
+	return ParseDecimal(p.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see ParseDecimal
+func (p *PriceLevel) QuantityDecimal() (decimal.Decimal, error) {
+	return ParseDecimal(p.Quantity)
+}
@@ -0,0 +1,371 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitPolicy define how a RateLimiter reacts when a request would exceed a known limit
+type RateLimitPolicy int
+
+// Rate limit policies
+const (
+	// RateLimitPolicyTrack only records the usage reported by the server, requests are never held back
+	RateLimitPolicyTrack RateLimitPolicy = iota
+	// RateLimitPolicyFail returns ErrRateLimitExceeded instead of sending the request
+	RateLimitPolicyFail
+	// RateLimitPolicyWait blocks until the window of the exceeded limit resets or the context is done
+	RateLimitPolicyWait
+)
+
+// Rate limit types, as published in exchangeInfo.rateLimits
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+)
+
+// Rate limit intervals, as published in exchangeInfo.rateLimits
+const (
+	RateLimitIntervalSecond = "SECOND"
+	RateLimitIntervalMinute = "MINUTE"
+	RateLimitIntervalHour   = "HOUR"
+	RateLimitIntervalDay    = "DAY"
+)
+
+const (
+	usedWeightHeaderPrefix = "X-Mbx-Used-Weight-"
+	orderCountHeaderPrefix = "X-Mbx-Order-Count-"
+)
+
+// ErrRateLimitExceeded is returned by RateLimiter.Reserve when the policy is RateLimitPolicyFail
+// and the request would exceed one of the known limits
+var ErrRateLimitExceeded = errors.New("rate limit: request would exceed the limit")
+
+// RateLimit define a rate limit rule as published in exchangeInfo.rateLimits
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// Window return the duration of the rate limit window
+func (l RateLimit) Window() time.Duration {
+	n := time.Duration(l.IntervalNum)
+	if n <= 0 {
+		n = 1
+	}
+	switch l.Interval {
+	case RateLimitIntervalSecond:
+		return n * time.Second
+	case RateLimitIntervalMinute:
+		return n * time.Minute
+	case RateLimitIntervalHour:
+		return n * time.Hour
+	case RateLimitIntervalDay:
+		return n * 24 * time.Hour
+	}
+	return 0
+}
+
+// Key return the interval key used in the rate limit response headers, e.g. 1M for one minute
+func (l RateLimit) Key() string {
+	if l.Interval == "" {
+		return ""
+	}
+	n := l.IntervalNum
+	if n <= 0 {
+		n = 1
+	}
+	return fmt.Sprintf("%d%s", n, l.Interval[:1])
+}
+
+// intervalKeyWindow parse an interval key like 10S or 1M into a duration
+func intervalKeyWindow(key string) time.Duration {
+	if len(key) < 2 {
+		return 0
+	}
+	n, err := strconv.ParseInt(key[:len(key)-1], 10, 64)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	switch key[len(key)-1] {
+	case 'S':
+		return time.Duration(n) * time.Second
+	case 'M':
+		return time.Duration(n) * time.Minute
+	case 'H':
+		return time.Duration(n) * time.Hour
+	case 'D':
+		return time.Duration(n) * 24 * time.Hour
+	}
+	return 0
+}
+
+// RateLimitUsage is a snapshot of the usage recorded by a RateLimiter.
+// Maps are keyed by interval, e.g. 1M or 10S.
+type RateLimitUsage struct {
+	UsedWeight map[string]int64
+	OrderCount map[string]int64
+	UpdateTime time.Time
+}
+
+type rateLimitCounter struct {
+	value       int64
+	windowStart time.Time
+	window      time.Duration
+}
+
+// current return the counter value, or zero if the window it was recorded in has passed
+func (c *rateLimitCounter) current(now time.Time) int64 {
+	if c.window > 0 && !now.Truncate(c.window).Equal(c.windowStart) {
+		return 0
+	}
+	return c.value
+}
+
+// RateLimiter records the request weight and order counts returned in the response headers
+// and optionally holds back requests which would exceed the limits set by SetLimits.
+// A RateLimiter is safe for concurrent use and may be shared by several clients.
+type RateLimiter struct {
+	mu         sync.Mutex
+	policy     RateLimitPolicy
+	limits     []RateLimit
+	usedWeight map[string]*rateLimitCounter
+	orderCount map[string]*rateLimitCounter
+	updateTime time.Time
+	now        func() time.Time
+}
+
+// NewRateLimiter init a RateLimiter with RateLimitPolicyTrack
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		policy:     RateLimitPolicyTrack,
+		usedWeight: make(map[string]*rateLimitCounter),
+		orderCount: make(map[string]*rateLimitCounter),
+		now:        time.Now,
+	}
+}
+
+// SetPolicy set the policy applied by Reserve
+func (l *RateLimiter) SetPolicy(policy RateLimitPolicy) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.policy = policy
+	return l
+}
+
+// SetLimits replace the limits checked by Reserve, usually with ExchangeInfo.RateLimits
+func (l *RateLimiter) SetLimits(limits ...RateLimit) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = append([]RateLimit(nil), limits...)
+	return l
+}
+
+// Limits return the limits checked by Reserve
+func (l *RateLimiter) Limits() []RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]RateLimit(nil), l.limits...)
+}
+
+// Update record the usage from the X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* response headers
+func (l *RateLimiter) Update(header http.Header) {
+	if len(header) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for k, v := range header {
+		if len(v) == 0 {
+			continue
+		}
+		var counters map[string]*rateLimitCounter
+		ck := http.CanonicalHeaderKey(k)
+		switch {
+		case strings.HasPrefix(ck, usedWeightHeaderPrefix):
+			counters = l.usedWeight
+			ck = ck[len(usedWeightHeaderPrefix):]
+		case strings.HasPrefix(ck, orderCountHeaderPrefix):
+			counters = l.orderCount
+			ck = ck[len(orderCountHeaderPrefix):]
+		default:
+			continue
+		}
+		value, err := strconv.ParseInt(v[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		key := strings.ToUpper(ck)
+		window := intervalKeyWindow(key)
+		counters[key] = &rateLimitCounter{
+			value:       value,
+			windowStart: now.Truncate(window),
+			window:      window,
+		}
+		l.updateTime = now
+	}
+}
+
+// UsedWeight return the last used weight reported for the interval, e.g. 1M
+func (l *RateLimiter) UsedWeight(interval string) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if c, ok := l.usedWeight[strings.ToUpper(interval)]; ok {
+		return c.current(l.now())
+	}
+	return 0
+}
+
+// OrderCount return the last order count reported for the interval, e.g. 10S or 1D
+func (l *RateLimiter) OrderCount(interval string) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if c, ok := l.orderCount[strings.ToUpper(interval)]; ok {
+		return c.current(l.now())
+	}
+	return 0
+}
+
+// Usage return a snapshot of all the recorded usage
+func (l *RateLimiter) Usage() RateLimitUsage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	usage := RateLimitUsage{
+		UsedWeight: make(map[string]int64, len(l.usedWeight)),
+		OrderCount: make(map[string]int64, len(l.orderCount)),
+		UpdateTime: l.updateTime,
+	}
+	for k, c := range l.usedWeight {
+		usage.UsedWeight[k] = c.current(now)
+	}
+	for k, c := range l.orderCount {
+		usage.OrderCount[k] = c.current(now)
+	}
+	return usage
+}
+
+// Reserve check that a request with the given weight fits in the limits set by SetLimits.
+// Depending on the policy it returns ErrRateLimitExceeded or waits for the window to reset.
+// On success the weight is added to the local counters until the next response updates them.
+func (l *RateLimiter) Reserve(ctx context.Context, weight int64, isOrder bool) error {
+	for {
+		l.mu.Lock()
+		if l.policy == RateLimitPolicyTrack {
+			l.mu.Unlock()
+			return nil
+		}
+		now := l.now()
+		wait, exceeded := l.exceeded(now, weight, isOrder)
+		if !exceeded {
+			l.consume(now, weight, isOrder)
+			l.mu.Unlock()
+			return nil
+		}
+		policy := l.policy
+		l.mu.Unlock()
+
+		if policy == RateLimitPolicyFail {
+			return ErrRateLimitExceeded
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// exceeded return whether a limit would be exceeded and how long to wait for its window to reset
+func (l *RateLimiter) exceeded(now time.Time, weight int64, isOrder bool) (time.Duration, bool) {
+	var wait time.Duration
+	exceeded := false
+	for _, limit := range l.limits {
+		var counters map[string]*rateLimitCounter
+		var cost int64
+		switch limit.RateLimitType {
+		case RateLimitTypeRequestWeight:
+			counters, cost = l.usedWeight, weight
+		case RateLimitTypeOrders:
+			if !isOrder {
+				continue
+			}
+			counters, cost = l.orderCount, 1
+		default:
+			continue
+		}
+		window := limit.Window()
+		if window <= 0 || limit.Limit <= 0 {
+			continue
+		}
+		var used int64
+		if c, ok := counters[limit.Key()]; ok {
+			used = c.current(now)
+		}
+		if used+cost <= limit.Limit {
+			continue
+		}
+		exceeded = true
+		if d := now.Truncate(window).Add(window).Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait, exceeded
+}
+
+// consume add the request cost to the counters of the known limits
+func (l *RateLimiter) consume(now time.Time, weight int64, isOrder bool) {
+	for _, limit := range l.limits {
+		var counters map[string]*rateLimitCounter
+		var cost int64
+		switch limit.RateLimitType {
+		case RateLimitTypeRequestWeight:
+			counters, cost = l.usedWeight, weight
+		case RateLimitTypeOrders:
+			if !isOrder {
+				continue
+			}
+			counters, cost = l.orderCount, 1
+		default:
+			continue
+		}
+		window := limit.Window()
+		if window <= 0 {
+			continue
+		}
+		key := limit.Key()
+		c, ok := counters[key]
+		if !ok || c.current(now) == 0 {
+			c = &rateLimitCounter{windowStart: now.Truncate(window), window: window}
+			counters[key] = c
+		}
+		c.value += cost
+	}
+}
+
+// IsOrderEndpoint report whether a request counts against the ORDERS rate limits
+func IsOrderEndpoint(method, endpoint string) bool {
+	if method != http.MethodPost {
+		return false
+	}
+	if strings.HasSuffix(endpoint, "/test") {
+		return false
+	}
+	endpoint = strings.ToLower(endpoint)
+	return strings.HasSuffix(endpoint, "/order") ||
+		strings.HasSuffix(endpoint, "/batchorders") ||
+		strings.Contains(endpoint, "/order/") ||
+		strings.Contains(endpoint, "/orderlist/")
+}
@@ -0,0 +1,101 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(now time.Time) *RateLimiter {
+	l := NewRateLimiter()
+	l.now = func() time.Time { return now }
+	return l
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(now)
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "120")
+	header.Set("X-MBX-USED-WEIGHT", "120")
+	header.Set("X-MBX-ORDER-COUNT-10S", "3")
+	header.Set("X-MBX-ORDER-COUNT-1D", "42")
+	l.Update(header)
+
+	assert.Equal(t, int64(120), l.UsedWeight("1M"))
+	assert.Equal(t, int64(120), l.UsedWeight("1m"))
+	assert.Equal(t, int64(3), l.OrderCount("10S"))
+	assert.Equal(t, int64(42), l.OrderCount("1D"))
+	assert.Equal(t, int64(0), l.OrderCount("1M"))
+
+	usage := l.Usage()
+	assert.Equal(t, map[string]int64{"1M": 120}, usage.UsedWeight)
+	assert.Equal(t, map[string]int64{"10S": 3, "1D": 42}, usage.OrderCount)
+	assert.Equal(t, now, usage.UpdateTime)
+
+	// counters expire once their window has passed
+	l.now = func() time.Time { return now.Add(time.Minute) }
+	assert.Equal(t, int64(0), l.UsedWeight("1M"))
+	assert.Equal(t, int64(0), l.OrderCount("10S"))
+	assert.Equal(t, int64(42), l.OrderCount("1D"))
+}
+
+func TestRateLimitKey(t *testing.T) {
+	assert.Equal(t, "1M", RateLimit{Interval: RateLimitIntervalMinute, IntervalNum: 1}.Key())
+	assert.Equal(t, "10S", RateLimit{Interval: RateLimitIntervalSecond, IntervalNum: 10}.Key())
+	assert.Equal(t, 24*time.Hour, RateLimit{Interval: RateLimitIntervalDay, IntervalNum: 1}.Window())
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC)
+	limits := []RateLimit{
+		{RateLimitType: RateLimitTypeRequestWeight, Interval: RateLimitIntervalMinute, IntervalNum: 1, Limit: 100},
+		{RateLimitType: RateLimitTypeOrders, Interval: RateLimitIntervalSecond, IntervalNum: 10, Limit: 2},
+	}
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "95")
+
+	l := newTestRateLimiter(now)
+	l.SetLimits(limits...)
+	l.Update(header)
+	// tracking never holds back requests
+	assert.NoError(t, l.Reserve(context.Background(), 10, false))
+
+	l.SetPolicy(RateLimitPolicyFail)
+	assert.NoError(t, l.Reserve(context.Background(), 5, false))
+	assert.Equal(t, int64(100), l.UsedWeight("1M"))
+	assert.ErrorIs(t, l.Reserve(context.Background(), 1, false), ErrRateLimitExceeded)
+
+	l = newTestRateLimiter(now).SetPolicy(RateLimitPolicyFail).SetLimits(limits...)
+	assert.NoError(t, l.Reserve(context.Background(), 1, true))
+	assert.NoError(t, l.Reserve(context.Background(), 1, true))
+	assert.ErrorIs(t, l.Reserve(context.Background(), 1, true), ErrRateLimitExceeded)
+	assert.NoError(t, l.Reserve(context.Background(), 1, false))
+}
+
+func TestRateLimiterReserveWait(t *testing.T) {
+	l := NewRateLimiter().SetPolicy(RateLimitPolicyWait).SetLimits(RateLimit{
+		RateLimitType: RateLimitTypeRequestWeight,
+		Interval:      RateLimitIntervalDay,
+		IntervalNum:   1,
+		Limit:         1,
+	})
+	assert.NoError(t, l.Reserve(context.Background(), 1, false))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Reserve(ctx, 1, false), context.DeadlineExceeded)
+}
+
+func TestIsOrderEndpoint(t *testing.T) {
+	assert.True(t, IsOrderEndpoint(http.MethodPost, "/api/v3/order"))
+	assert.True(t, IsOrderEndpoint(http.MethodPost, "/api/v3/order/oco"))
+	assert.True(t, IsOrderEndpoint(http.MethodPost, "/fapi/v1/batchOrders"))
+	assert.True(t, IsOrderEndpoint(http.MethodPost, "/sapi/v1/margin/order"))
+	assert.False(t, IsOrderEndpoint(http.MethodPost, "/api/v3/order/test"))
+	assert.False(t, IsOrderEndpoint(http.MethodGet, "/api/v3/order"))
+	assert.False(t, IsOrderEndpoint(http.MethodDelete, "/api/v3/order"))
+}
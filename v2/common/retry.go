@@ -0,0 +1,173 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// RetryAttempt describe a failed attempt handed to a RetryPolicy
+type RetryAttempt struct {
+	// Attempt is the number of attempts made so far, starting at 1
+	Attempt    int
+	Method     string
+	Endpoint   string
+	StatusCode int // 0 when no response was received
+	Header     http.Header
+	Err        error
+}
+
+// RetryPolicy decide whether a failed request is sent again and after which delay.
+// Signed requests are signed again with a fresh timestamp before each retry.
+type RetryPolicy interface {
+	Retry(attempt *RetryAttempt) (delay time.Duration, retry bool)
+}
+
+// RetryPolicyFunc is an adapter to allow the use of ordinary functions as RetryPolicy
+type RetryPolicyFunc func(attempt *RetryAttempt) (time.Duration, bool)
+
+// Retry call f(attempt)
+func (f RetryPolicyFunc) Retry(attempt *RetryAttempt) (time.Duration, bool) {
+	return f(attempt)
+}
+
+// BackoffRetryPolicy retries rate limited, timestamp rejected and, for idempotent requests,
+// server side and network failures with an exponential backoff
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	MinDelay    time.Duration
+	MaxDelay    time.Duration
+	Factor      float64
+	Jitter      bool
+	// MaxRetryAfter is the longest Retry-After delay the policy waits for,
+	// requests asked to wait longer (e.g. IP bans) fail immediately
+	MaxRetryAfter time.Duration
+	// RetryNonIdempotent also retries requests like order placement after failures
+	// which leave their execution status unknown (5xx, timeouts, network errors)
+	RetryNonIdempotent bool
+}
+
+// NewBackoffRetryPolicy init a BackoffRetryPolicy with default settings
+func NewBackoffRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts:   3,
+		MinDelay:      200 * time.Millisecond,
+		MaxDelay:      5 * time.Second,
+		Factor:        2,
+		Jitter:        true,
+		MaxRetryAfter: time.Minute,
+	}
+}
+
+// Retry implement RetryPolicy
+func (p *BackoffRetryPolicy) Retry(a *RetryAttempt) (time.Duration, bool) {
+	if a.Attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if !IsRetryableAttempt(a) {
+		if !p.RetryNonIdempotent || !isAmbiguousFailure(a) {
+			return 0, false
+		}
+	}
+	b := &backoff.Backoff{
+		Min:    p.MinDelay,
+		Max:    p.MaxDelay,
+		Factor: p.Factor,
+		Jitter: p.Jitter,
+	}
+	delay := b.ForAttempt(float64(a.Attempt - 1))
+	retryAfter, ok := ParseRetryAfter(a.Header, time.Now())
+	if !ok {
+		// never guess how long an IP ban lasts
+		return delay, a.StatusCode != statusIPBanned
+	}
+	if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+		return 0, false
+	}
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	return delay, true
+}
+
+// IsRetryableAttempt report whether a failed attempt is safe to send again:
+// requests rejected by the rate limits of the server or for their timestamp were never executed,
+// while server side and network failures are only retried for idempotent requests.
+// The requests held back by the local RateLimiter are not retried, its budget is still exhausted.
+func IsRetryableAttempt(a *RetryAttempt) bool {
+	if errors.Is(a.Err, context.Canceled) || errors.Is(a.Err, context.DeadlineExceeded) {
+		return false
+	}
+	if a.StatusCode == http.StatusTooManyRequests || a.StatusCode == statusIPBanned {
+		return true
+	}
+	if IsRateLimited(a.Err) || errors.Is(a.Err, ErrInvalidTimestamp) {
+		return true
+	}
+	return isAmbiguousFailure(a) && IsIdempotentMethod(a.Method)
+}
+
+// isAmbiguousFailure report whether the request may or may not have been executed
+func isAmbiguousFailure(a *RetryAttempt) bool {
+	if errors.Is(a.Err, context.Canceled) || errors.Is(a.Err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(a.Err, ErrRateLimitExceeded) {
+		// held back by the RateLimiter, the request was never sent
+		return false
+	}
+	return a.StatusCode == 0 || a.StatusCode >= http.StatusInternalServerError
+}
+
+// IsIdempotentMethod report whether requests with the HTTP method can be sent twice without side effects
+func IsIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// ParseRetryAfter return the delay requested by the Retry-After header,
+// given either in seconds or as an HTTP date
+func ParseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	v := strings.TrimSpace(header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// WaitRetry wait for the retry delay, it returns the context error if ctx is done first
+func WaitRetry(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := NewBackoffRetryPolicy()
+	p.Jitter = false
+	retryAfter := http.Header{}
+	retryAfter.Set("Retry-After", "2")
+	ban := http.Header{}
+	ban.Set("Retry-After", "3600")
+
+	tests := []struct {
+		name    string
+		attempt *RetryAttempt
+		retry   bool
+		delay   time.Duration
+	}{
+		{
+			name:    "rate limited order placement",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodPost, StatusCode: http.StatusTooManyRequests, Header: retryAfter, Err: &APIError{Code: -1003}},
+			retry:   true,
+			delay:   2 * time.Second,
+		},
+		{
+			name:    "timestamp outside of recvWindow",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodPost, StatusCode: http.StatusBadRequest, Err: &APIError{Code: -1021}},
+			retry:   true,
+			delay:   200 * time.Millisecond,
+		},
+		{
+			name:    "gateway error on query",
+			attempt: &RetryAttempt{Attempt: 2, Method: http.MethodGet, StatusCode: http.StatusBadGateway, Err: &APIError{}},
+			retry:   true,
+			delay:   400 * time.Millisecond,
+		},
+		{
+			name:    "gateway error on order placement",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodPost, StatusCode: http.StatusBadGateway, Err: &APIError{}},
+			retry:   false,
+		},
+		{
+			name:    "network error on query",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodGet, Err: errors.New("connection reset")},
+			retry:   true,
+			delay:   200 * time.Millisecond,
+		},
+		{
+			name:    "rejected order",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodPost, StatusCode: http.StatusBadRequest, Err: &APIError{Code: -2010}},
+			retry:   false,
+		},
+		{
+			name:    "ip ban longer than MaxRetryAfter",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodGet, StatusCode: 418, Header: ban, Err: &APIError{Code: -1003}},
+			retry:   false,
+		},
+		{
+			name:    "ip ban without Retry-After",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodGet, StatusCode: 418, Err: &APIError{Code: -1003}},
+			retry:   false,
+		},
+		{
+			name:    "query held back by the local rate limiter",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodGet, Err: ErrRateLimitExceeded},
+			retry:   false,
+		},
+		{
+			name:    "cancelled context",
+			attempt: &RetryAttempt{Attempt: 1, Method: http.MethodGet, Err: context.Canceled},
+			retry:   false,
+		},
+		{
+			name:    "max attempts",
+			attempt: &RetryAttempt{Attempt: 3, Method: http.MethodGet, StatusCode: http.StatusTooManyRequests, Err: &APIError{Code: -1003}},
+			retry:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := p.Retry(tt.attempt)
+			assert.Equal(t, tt.retry, retry)
+			if tt.retry {
+				assert.Equal(t, tt.delay, delay)
+			}
+		})
+	}
+
+	p.RetryNonIdempotent = true
+	_, retry := p.Retry(&RetryAttempt{Attempt: 1, Method: http.MethodPost, StatusCode: http.StatusServiceUnavailable, Err: &APIError{}})
+	assert.True(t, retry)
+	_, retry = p.Retry(&RetryAttempt{Attempt: 1, Method: http.MethodPost, Err: ErrRateLimitExceeded})
+	assert.False(t, retry)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	_, ok := ParseRetryAfter(header, now)
+	assert.False(t, ok)
+
+	header.Set("Retry-After", "30")
+	d, ok := ParseRetryAfter(header, now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, d)
+
+	header.Set("Retry-After", now.Add(time.Minute).Format(http.TimeFormat))
+	d, ok = ParseRetryAfter(header, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+}
+
+func TestWaitRetry(t *testing.T) {
+	assert.NoError(t, WaitRetry(context.Background(), time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, WaitRetry(ctx, time.Hour), context.Canceled)
+}
@@ -44,6 +44,41 @@ func Hmac(secretKey string, data string) (*string, error) {
 }
 
 func Rsa(secretKey string, data string) (*string, error) {
+	rsaPrivateKey, err := parseRsaKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	encodedSignature, err := signRsa(rsaPrivateKey, data)
+	if err != nil {
+		return nil, err
+	}
+	return &encodedSignature, nil
+}
+
+func Ed25519(secretKey string, data string) (*string, error) {
+	ed25519PrivateKey, err := parseEd25519Key(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	encodedSignature := signEd25519(ed25519PrivateKey, data)
+	return &encodedSignature, nil
+}
+
+func signRsa(key *rsa.PrivateKey, data string) (string, error) {
+	hashed := sha256.Sum256([]byte(data))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+func signEd25519(key ed25519.PrivateKey, data string) string {
+	signature := ed25519.Sign(key, []byte(data))
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func parseRsaKey(secretKey string) (*rsa.PrivateKey, error) {
 	block, _ := pem.Decode([]byte(secretKey))
 	if block == nil {
 		return nil, errors.New("Rsa pem.Decode failed, invalid pem format secretKey")
@@ -56,16 +91,10 @@ func Rsa(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Rsa convert PrivateKey failed")
 	}
-	hashed := sha256.Sum256([]byte(data))
-	signature, err := rsa.SignPKCS1v15(rand.Reader, rsaPrivateKey, crypto.SHA256, hashed[:])
-	if err != nil {
-		return nil, err
-	}
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return rsaPrivateKey, nil
 }
 
-func Ed25519(secretKey string, data string) (*string, error) {
+func parseEd25519Key(secretKey string) (ed25519.PrivateKey, error) {
 	block, _ := pem.Decode([]byte(secretKey))
 	if block == nil {
 		return nil, fmt.Errorf("Ed25519 pem.Decode failed, invalid pem format secretKey")
@@ -78,8 +107,5 @@ func Ed25519(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Ed25519 convert PrivateKey failed")
 	}
-	pk := ed25519.PrivateKey(ed25519PrivateKey)
-	signature := ed25519.Sign(pk, []byte(data))
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return ed25519PrivateKey, nil
 }
@@ -0,0 +1,255 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Signer sign the payload of signed requests, i.e. the query string followed by the form body
+// for REST requests and the sorted parameters for websocket API requests.
+// Implementations may keep the secret outside of the process (KMS, HSM, signing agent).
+type Signer interface {
+	Sign(payload string) (signature string, err error)
+}
+
+// ContextSigner is a Signer calling out to a service, the clients sign with the context of the request
+// so that its cancellation and deadline also bound the signing
+type ContextSigner interface {
+	Signer
+	SignContext(ctx context.Context, payload string) (signature string, err error)
+}
+
+// SignContext sign payload with the context when signer is a ContextSigner, with Sign otherwise
+func SignContext(ctx context.Context, signer Signer, payload string) (string, error) {
+	if s, ok := signer.(ContextSigner); ok {
+		return s.SignContext(ctx, payload)
+	}
+	return signer.Sign(payload)
+}
+
+// SignerFunc is an adapter to allow the use of ordinary functions as Signer
+type SignerFunc func(payload string) (string, error)
+
+// Sign call f(payload)
+func (f SignerFunc) Sign(payload string) (string, error) {
+	return f(payload)
+}
+
+// ContextSignerFunc is an adapter to allow the use of ordinary functions as ContextSigner
+type ContextSignerFunc func(ctx context.Context, payload string) (string, error)
+
+// Sign call f with a background context
+func (f ContextSignerFunc) Sign(payload string) (string, error) {
+	return f(context.Background(), payload)
+}
+
+// SignContext call f(ctx, payload)
+func (f ContextSignerFunc) SignContext(ctx context.Context, payload string) (string, error) {
+	return f(ctx, payload)
+}
+
+// SignerCache keep the in-process Signer of a key type and secret key, so that a private key is parsed
+// once per client instead of once per request. The zero value is ready to use.
+type SignerCache struct {
+	mu        sync.Mutex
+	keyType   string
+	secretKey string
+	signer    Signer
+}
+
+// Signer return the Signer of keyType and secretKey, it is created again when they changed
+func (c *SignerCache) Signer(keyType, secretKey string) (Signer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.signer == nil || c.keyType != keyType || c.secretKey != secretKey {
+		signer, err := NewSigner(keyType, secretKey)
+		if err != nil {
+			return nil, err
+		}
+		c.keyType, c.secretKey, c.signer = keyType, secretKey, signer
+	}
+	return c.signer, nil
+}
+
+// NewSigner init the in-process Signer of the key type, secretKey is the HMAC secret
+// or the PEM encoded PKCS#8 private key, which is parsed once by the signer.
+func NewSigner(keyType, secretKey string) (Signer, error) {
+	switch keyType {
+	case KeyTypeHmac, "":
+		return NewHmacSigner(secretKey), nil
+	case KeyTypeRsa:
+		return NewRsaSigner(secretKey)
+	case KeyTypeEd25519:
+		return NewEd25519Signer(secretKey)
+	}
+	return nil, fmt.Errorf("unsupported keyType=%s", keyType)
+}
+
+// HmacSigner sign with HMAC SHA256
+type HmacSigner struct {
+	secretKey string
+}
+
+// NewHmacSigner init a HmacSigner
+func NewHmacSigner(secretKey string) *HmacSigner {
+	return &HmacSigner{secretKey: secretKey}
+}
+
+// Sign implement Signer
+func (s *HmacSigner) Sign(payload string) (string, error) {
+	signature, err := Hmac(s.secretKey, payload)
+	if err != nil {
+		return "", err
+	}
+	return *signature, nil
+}
+
+// RsaSigner sign with RSASSA-PKCS1-v1_5 and SHA256
+type RsaSigner struct {
+	key *rsa.PrivateKey
+}
+
+// NewRsaSigner init a RsaSigner from a PEM encoded PKCS#8 private key
+func NewRsaSigner(secretKey string) (*RsaSigner, error) {
+	key, err := parseRsaKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewRsaSignerFromKey(key), nil
+}
+
+// NewRsaSignerFromKey init a RsaSigner from a parsed private key
+func NewRsaSignerFromKey(key *rsa.PrivateKey) *RsaSigner {
+	return &RsaSigner{key: key}
+}
+
+// Sign implement Signer
+func (s *RsaSigner) Sign(payload string) (string, error) {
+	return signRsa(s.key, payload)
+}
+
+// Ed25519Signer sign with Ed25519
+type Ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519Signer init an Ed25519Signer from a PEM encoded PKCS#8 private key
+func NewEd25519Signer(secretKey string) (*Ed25519Signer, error) {
+	key, err := parseEd25519Key(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewEd25519SignerFromKey(key), nil
+}
+
+// NewEd25519SignerFromKey init an Ed25519Signer from a parsed private key
+func NewEd25519SignerFromKey(key ed25519.PrivateKey) *Ed25519Signer {
+	return &Ed25519Signer{key: key}
+}
+
+// Sign implement Signer
+func (s *Ed25519Signer) Sign(payload string) (string, error) {
+	return signEd25519(s.key, payload), nil
+}
+
+// ErrSignerUnavailable is returned by RemoteSigner when the signing service can not be reached
+var ErrSignerUnavailable = errors.New("remote signer unavailable")
+
+// RemoteSignRequest is the body posted by RemoteSigner
+type RemoteSignRequest struct {
+	KeyID   string `json:"keyId,omitempty"`
+	Payload string `json:"payload"`
+}
+
+// RemoteSignResponse is the body expected from the signing service
+type RemoteSignResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RemoteSigner delegate signing to a service holding the private key, e.g. an agent in front of a KMS or HSM.
+// It posts a RemoteSignRequest as JSON to URL and expects a RemoteSignResponse with status 200.
+type RemoteSigner struct {
+	URL string
+	// KeyID tells the service which key to sign with
+	KeyID string
+	// Header is added to every signing request, e.g. for authentication
+	Header     http.Header
+	HTTPClient *http.Client
+	// Timeout bounds every signing request, 0 means no timeout
+	Timeout time.Duration
+}
+
+// NewRemoteSigner init a RemoteSigner
+func NewRemoteSigner(url, keyID string) *RemoteSigner {
+	return &RemoteSigner{
+		URL:        url,
+		KeyID:      keyID,
+		HTTPClient: http.DefaultClient,
+		Timeout:    5 * time.Second,
+	}
+}
+
+// Sign implement Signer
+func (s *RemoteSigner) Sign(payload string) (string, error) {
+	return s.SignContext(context.Background(), payload)
+}
+
+// SignContext implement ContextSigner, Timeout bounds the signing request within ctx
+func (s *RemoteSigner) SignContext(parent context.Context, payload string) (string, error) {
+	ctx := parent
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	body, err := json.Marshal(RemoteSignRequest{KeyID: s.KeyID, Payload: payload})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	for k, v := range s.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c := s.HTTPClient
+	if c == nil {
+		c = http.DefaultClient
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		if parent.Err() != nil {
+			return "", parent.Err()
+		}
+		return "", fmt.Errorf("%w: %v", ErrSignerUnavailable, err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrSignerUnavailable, err)
+	}
+	rsp := new(RemoteSignResponse)
+	if err := json.Unmarshal(data, rsp); err != nil && res.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("remote signer: invalid response: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || rsp.Error != "" || rsp.Signature == "" {
+		msg := rsp.Error
+		if msg == "" {
+			msg = string(data)
+		}
+		return "", fmt.Errorf("remote signer: status=%d, error=%s", res.StatusCode, msg)
+	}
+	return rsp.Signature, nil
+}
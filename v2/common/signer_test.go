@@ -0,0 +1,175 @@
+package common
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pemKey(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestHmacSigner(t *testing.T) {
+	signer, err := NewSigner(KeyTypeHmac, "NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+	require.NoError(t, err)
+	signature, err := signer.Sign("symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559")
+	assert.NoError(t, err)
+	assert.Equal(t, "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71", signature)
+}
+
+func TestRsaSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	secretKey := pemKey(t, key)
+
+	signer, err := NewSigner(KeyTypeRsa, secretKey)
+	require.NoError(t, err)
+	signature, err := signer.Sign("payload")
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	hashed := sha256.Sum256([]byte("payload"))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], raw))
+
+	// the parsed key is held by the signer
+	assert.Equal(t, key.D, signer.(*RsaSigner).key.D)
+
+	_, err = NewRsaSigner("invalid")
+	assert.Error(t, err)
+}
+
+func TestEd25519Signer(t *testing.T) {
+	public, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	secretKey := pemKey(t, key)
+
+	signer, err := NewSigner(KeyTypeEd25519, secretKey)
+	require.NoError(t, err)
+	signature, err := signer.Sign("payload")
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(public, []byte("payload"), raw))
+
+	// signatures match the ones of SignFunc
+	sf, err := SignFunc(KeyTypeEd25519)
+	require.NoError(t, err)
+	expected, err := sf(secretKey, "payload")
+	require.NoError(t, err)
+	assert.Equal(t, *expected, signature)
+
+	_, err = NewSigner("DSA", secretKey)
+	assert.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	hmacSigner := NewHmacSigner("secret")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer token")) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(RemoteSignResponse{Error: "unauthorized"})
+			return
+		}
+		req := new(RemoteSignRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil || req.KeyID != "trading" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		signature, _ := hmacSigner.Sign(req.Payload)
+		_ = json.NewEncoder(w).Encode(RemoteSignResponse{Signature: signature})
+	}))
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, "trading")
+	_, err := signer.Sign("payload")
+	assert.EqualError(t, err, "remote signer: status=401, error=unauthorized")
+
+	signer.Header = http.Header{}
+	signer.Header.Set("Authorization", "Bearer token")
+	signature, err := signer.Sign("payload")
+	assert.NoError(t, err)
+	expected, _ := hmacSigner.Sign("payload")
+	assert.Equal(t, expected, signature)
+
+	server.Close()
+	_, err = signer.Sign("payload")
+	assert.True(t, errors.Is(err, ErrSignerUnavailable))
+}
+
+func TestRemoteSignerContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	signer := NewRemoteSigner(server.URL, "trading")
+	signer.Timeout = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := SignContext(ctx, signer, "payload")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSignContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	signer := ContextSignerFunc(func(ctx context.Context, payload string) (string, error) {
+		return ctx.Value(ctxKey{}).(string) + ":" + payload, nil
+	})
+	signature, err := SignContext(ctx, signer, "payload")
+	require.NoError(t, err)
+	assert.Equal(t, "request:payload", signature)
+
+	// a Signer without context is called with Sign
+	signature, err = SignContext(ctx, SignerFunc(func(payload string) (string, error) {
+		return "plain:" + payload, nil
+	}), "payload")
+	require.NoError(t, err)
+	assert.Equal(t, "plain:payload", signature)
+}
+
+func TestSignerCache(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pemKey1 := pemKey(t, priv)
+
+	var cache SignerCache
+	s1, err := cache.Signer(KeyTypeEd25519, pemKey1)
+	require.NoError(t, err)
+	s2, err := cache.Signer(KeyTypeEd25519, pemKey1)
+	require.NoError(t, err)
+	assert.Same(t, s1, s2, "the key is parsed once")
+
+	s3, err := cache.Signer(KeyTypeHmac, "secret")
+	require.NoError(t, err)
+	assert.IsType(t, &HmacSigner{}, s3)
+	_, err = cache.Signer(KeyTypeRsa, "invalid")
+	assert.Error(t, err)
+	s4, err := cache.Signer(KeyTypeHmac, "secret")
+	require.NoError(t, err)
+	assert.Same(t, s3, s4, "a failed key does not replace the cached signer")
+}
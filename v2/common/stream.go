@@ -0,0 +1,196 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrStreamClosed is the error of a Stream closed by Close, or ended without an error
+var ErrStreamClosed = errors.New("stream closed")
+
+// StreamEventType define the type of a StreamEvent
+type StreamEventType string
+
+// Stream event types
+const (
+	StreamEventMessage StreamEventType = "MESSAGE"
+	// StreamEventError is an error of the connection, the stream goes on when it is reconnected
+	StreamEventError        StreamEventType = "ERROR"
+	StreamEventConnected    StreamEventType = "CONNECTED"
+	StreamEventDisconnected StreamEventType = "DISCONNECTED"
+	StreamEventReconnecting StreamEventType = "RECONNECTING"
+	// StreamEventGap is sent after a reconnection, the messages between GapStart and GapEnd were lost
+	StreamEventGap StreamEventType = "GAP"
+)
+
+// StreamEvent define a message, an error or a change of the connection of a Stream
+type StreamEvent[T any] struct {
+	Type StreamEventType
+	// Data is the message of the StreamEventMessage events
+	Data T
+	// Err is the error of the StreamEventError and StreamEventDisconnected events
+	Err      error
+	Endpoint string
+	// Attempt and Delay describe the StreamEventReconnecting events
+	Attempt  int
+	Delay    time.Duration
+	GapStart time.Time
+	GapEnd   time.Time
+}
+
+// StreamServeFunc open a websocket stream calling handler with its messages, reconnect is the
+// reconnection of the stream, nil when it is not reconnected
+type StreamServeFunc[T any] func(handler func(event T), errHandler func(err error), reconnect *WsReconnect) (doneC, stopC chan struct{}, err error)
+
+// Stream deliver the messages, the errors and the connection changes of a websocket stream on a channel.
+// The stream is stopped when the context given to NewStream is done or Close is called.
+type Stream[T any] struct {
+	events    chan StreamEvent[T]
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	err     error
+	stopped bool
+	ended   bool
+}
+
+// NewStream open a stream with serve, the callbacks of reconnect are called as well as the
+// connection changes are sent on the stream
+func NewStream[T any](ctx context.Context, reconnect *WsReconnect, serve StreamServeFunc[T]) (*Stream[T], error) {
+	s := &Stream[T]{
+		events: make(chan StreamEvent[T], 64),
+		done:   make(chan struct{}),
+	}
+	if reconnect != nil {
+		reconnect = s.wrapReconnect(*reconnect)
+	}
+	doneC, stopC, err := serve(func(event T) {
+		s.emit(StreamEvent[T]{Type: StreamEventMessage, Data: event})
+	}, func(err error) {
+		s.mu.Lock()
+		if !s.stopped {
+			s.err = err
+		}
+		s.mu.Unlock()
+		s.emit(StreamEvent[T]{Type: StreamEventError, Err: err})
+	}, reconnect)
+	if err != nil {
+		return nil, err
+	}
+	if reconnect == nil {
+		s.emit(StreamEvent[T]{Type: StreamEventConnected})
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.stop(ctx.Err())
+			close(stopC)
+			<-doneC
+		case <-s.done:
+			close(stopC)
+			<-doneC
+		case <-doneC:
+		}
+		s.mu.Lock()
+		s.ended = true
+		s.mu.Unlock()
+		close(s.events)
+	}()
+	return s, nil
+}
+
+// wrapReconnect return a copy of r sending the connection changes on the stream
+func (s *Stream[T]) wrapReconnect(r WsReconnect) *WsReconnect {
+	orig := r
+	r.OnConnected = func(endpoint string) {
+		if orig.OnConnected != nil {
+			orig.OnConnected(endpoint)
+		}
+		s.emit(StreamEvent[T]{Type: StreamEventConnected, Endpoint: endpoint})
+	}
+	r.OnDisconnected = func(endpoint string, err error) {
+		if orig.OnDisconnected != nil {
+			orig.OnDisconnected(endpoint, err)
+		}
+		s.emit(StreamEvent[T]{Type: StreamEventDisconnected, Endpoint: endpoint, Err: err})
+	}
+	r.OnReconnecting = func(endpoint string, attempt int, delay time.Duration) {
+		if orig.OnReconnecting != nil {
+			orig.OnReconnecting(endpoint, attempt, delay)
+		}
+		s.emit(StreamEvent[T]{Type: StreamEventReconnecting, Endpoint: endpoint, Attempt: attempt, Delay: delay})
+	}
+	r.OnGap = func(endpoint string, start, end time.Time) {
+		if orig.OnGap != nil {
+			orig.OnGap(endpoint, start, end)
+		}
+		s.emit(StreamEvent[T]{Type: StreamEventGap, Endpoint: endpoint, GapStart: start, GapEnd: end})
+	}
+	return &r
+}
+
+// emit send an event unless the stream is stopped
+func (s *Stream[T]) emit(e StreamEvent[T]) {
+	select {
+	case s.events <- e:
+	case <-s.done:
+	}
+}
+
+// stop record the cause of the end of the stream and release the blocked handlers
+func (s *Stream[T]) stop(err error) {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.err = err
+		s.stopped = true
+		s.mu.Unlock()
+		close(s.done)
+	})
+}
+
+// Events return the events of the stream, the channel is closed when the stream ends.
+// A stream is read either with Events or with Next.
+func (s *Stream[T]) Events() <-chan StreamEvent[T] {
+	return s.events
+}
+
+// Next return the next message, skipping the other events. The error is ctx.Err() when ctx is done,
+// Err() once the stream ended.
+func (s *Stream[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case e, ok := <-s.events:
+			if !ok {
+				return zero, s.Err()
+			}
+			if e.Type == StreamEventMessage {
+				return e.Data, nil
+			}
+		}
+	}
+}
+
+// Err return the cause of the end of the stream: the error of the context, ErrStreamClosed after Close,
+// or the last error of the connection. It is nil while the stream runs.
+func (s *Stream[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ended {
+		return nil
+	}
+	if s.err == nil {
+		return ErrStreamClosed
+	}
+	return s.err
+}
+
+// Close stop the stream
+func (s *Stream[T]) Close() {
+	s.stop(ErrStreamClosed)
+}
@@ -0,0 +1,635 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrStreamMuxClosed is returned by the methods of a closed StreamMux
+var ErrStreamMuxClosed = errors.New("stream mux closed")
+
+// errMuxDisconnected is returned by the requests sent on a connection which is reconnecting
+var errMuxDisconnected = errors.New("stream mux connection lost")
+
+// StreamHandler handle the data of a message of a combined stream, stream is its name e.g. btcusdt@depth
+type StreamHandler func(stream string, data []byte)
+
+// StreamMuxConfig define the connections of a StreamMux
+type StreamMuxConfig struct {
+	// URL is the base URL of the combined streams without any stream, e.g. wss://stream.binance.com:9443/stream
+	URL   string
+	Proxy *string
+	// MaxStreams is the number of streams of a connection, 1024 by default
+	MaxStreams int
+	// MessagesPerSecond is the number of frames a connection may send per second, 5 by default
+	MessagesPerSecond int
+	// RequestTimeout bound the wait for the response of a SUBSCRIBE, UNSUBSCRIBE or LIST_SUBSCRIPTIONS, 10s by default
+	RequestTimeout time.Duration
+	// Reconnect reconnects the lost connections and subscribes their streams again,
+	// the streams of a lost connection are removed when nil
+	Reconnect *WsReconnect
+	// ErrHandler receive the errors of the connections and the rejected requests without a caller
+	ErrHandler func(err error)
+}
+
+// StreamMux share a few connections between many streams, they are subscribed and unsubscribed
+// with SUBSCRIBE and UNSUBSCRIBE frames instead of opening a connection per stream.
+// A new connection is opened when the others reached MaxStreams, and the frames sent on a
+// connection are spaced to respect MessagesPerSecond.
+//
+// The handlers are called in the reading goroutine of their connection, they must not subscribe
+// or unsubscribe synchronously.
+type StreamMux struct {
+	cfg    StreamMuxConfig
+	nextID int64
+	done   chan struct{}
+
+	// opMu serialize the changes of the subscriptions, including the ones of the reconnections
+	opMu   sync.Mutex
+	mu     sync.RWMutex
+	conns  []*muxConn
+	routes map[string]*muxRoute
+	closed bool
+}
+
+// muxRoute is a subscribed stream
+type muxRoute struct {
+	handler StreamHandler
+	conn    *muxConn
+}
+
+// muxConn is a connection of a StreamMux
+type muxConn struct {
+	mux *StreamMux
+	// streams are guarded by mux.mu
+	streams map[string]struct{}
+
+	mu      sync.Mutex
+	ws      *websocket.Conn // nil while reconnecting
+	pending map[int64]chan muxResponse
+	// dropped is set when the connection is closed by the mux, it is not reconnected
+	dropped bool
+
+	writeMu   sync.Mutex
+	lastWrite time.Time
+}
+
+// muxRequest define a frame sent to the server
+type muxRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     int64    `json:"id"`
+}
+
+// muxMessage define a frame received from the server, either a response or a stream message
+type muxMessage struct {
+	ID     *int64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *APIError       `json:"error"`
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// muxResponse is the result of a request
+type muxResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// NewStreamMux init a StreamMux, the connections are opened by the subscriptions
+func NewStreamMux(cfg StreamMuxConfig) *StreamMux {
+	if cfg.MaxStreams <= 0 {
+		cfg.MaxStreams = 1024
+	}
+	if cfg.MessagesPerSecond <= 0 {
+		cfg.MessagesPerSecond = 5
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = 10 * time.Second
+	}
+	if cfg.ErrHandler == nil {
+		cfg.ErrHandler = func(err error) {}
+	}
+	return &StreamMux{
+		cfg:    cfg,
+		done:   make(chan struct{}),
+		routes: map[string]*muxRoute{},
+	}
+}
+
+// Subscribe call handler with the messages of streams. The handler of a stream which is already
+// subscribed is replaced without sending a frame. When an error is returned the streams which
+// were not confirmed by the server are not subscribed.
+func (m *StreamMux) Subscribe(handler StreamHandler, streams ...string) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrStreamMuxClosed
+	}
+	var added []string
+	for _, stream := range streams {
+		if r, ok := m.routes[stream]; ok {
+			r.handler = handler
+			continue
+		}
+		m.routes[stream] = &muxRoute{handler: handler}
+		added = append(added, stream)
+	}
+	// fill the spare capacity of the open connections first
+	var batches []muxBatch
+	for _, c := range m.conns {
+		if len(added) == 0 {
+			break
+		}
+		n := m.cfg.MaxStreams - len(c.streams)
+		if n <= 0 {
+			continue
+		}
+		if n > len(added) {
+			n = len(added)
+		}
+		batches = append(batches, muxBatch{conn: c, streams: added[:n]})
+		m.assign(c, added[:n])
+		added = added[n:]
+	}
+	m.mu.Unlock()
+	// opened are the connections opened for the streams, they are closed when their streams are rolled back
+	var opened []*muxConn
+	for len(added) > 0 {
+		c, err := m.open()
+		if err != nil {
+			m.rollback(append(batches, muxBatch{streams: added}), opened)
+			return err
+		}
+		opened = append(opened, c)
+		n := m.cfg.MaxStreams
+		if n > len(added) {
+			n = len(added)
+		}
+		m.mu.Lock()
+		m.assign(c, added[:n])
+		m.mu.Unlock()
+		batches = append(batches, muxBatch{conn: c, streams: added[:n]})
+		added = added[n:]
+	}
+	for i, b := range batches {
+		_, err := b.conn.request("SUBSCRIBE", b.streams)
+		if errors.Is(err, errMuxDisconnected) && m.cfg.Reconnect != nil {
+			// the streams are subscribed by the reconnection
+			continue
+		}
+		if err != nil {
+			m.rollback(batches[i:], opened)
+			return err
+		}
+	}
+	return nil
+}
+
+// muxBatch is the streams of a request on a connection
+type muxBatch struct {
+	conn    *muxConn
+	streams []string
+}
+
+// assign route streams to c, mu must be locked
+func (m *StreamMux) assign(c *muxConn, streams []string) {
+	for _, stream := range streams {
+		m.routes[stream].conn = c
+		c.streams[stream] = struct{}{}
+	}
+}
+
+// rollback remove the streams of batches which were not subscribed, and close the connections
+// of opened which are left without a stream
+func (m *StreamMux) rollback(batches []muxBatch, opened []*muxConn) {
+	m.mu.Lock()
+	for _, b := range batches {
+		for _, stream := range b.streams {
+			delete(m.routes, stream)
+			if b.conn != nil {
+				delete(b.conn.streams, stream)
+			}
+		}
+	}
+	var empty []*muxConn
+	for _, c := range opened {
+		if len(c.streams) == 0 {
+			m.removeConn(c)
+			empty = append(empty, c)
+		}
+	}
+	m.mu.Unlock()
+	for _, c := range empty {
+		c.close()
+	}
+}
+
+// Unsubscribe stop the messages of streams, the unknown streams are ignored
+func (m *StreamMux) Unsubscribe(streams ...string) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrStreamMuxClosed
+	}
+	var batches []muxBatch
+	index := map[*muxConn]int{}
+	for _, stream := range streams {
+		r, ok := m.routes[stream]
+		if !ok {
+			continue
+		}
+		delete(m.routes, stream)
+		delete(r.conn.streams, stream)
+		i, ok := index[r.conn]
+		if !ok {
+			i = len(batches)
+			index[r.conn] = i
+			batches = append(batches, muxBatch{conn: r.conn})
+		}
+		batches[i].streams = append(batches[i].streams, stream)
+	}
+	m.mu.Unlock()
+	var firstErr error
+	for _, b := range batches {
+		// a reconnecting connection does not subscribe the removed streams again
+		if _, err := b.conn.request("UNSUBSCRIBE", b.streams); err != nil && !errors.Is(err, errMuxDisconnected) && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// ListSubscriptions return the streams subscribed on the connections according to the server, ordered by name
+func (m *StreamMux) ListSubscriptions() ([]string, error) {
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		return nil, ErrStreamMuxClosed
+	}
+	conns := append([]*muxConn(nil), m.conns...)
+	m.mu.RUnlock()
+	res := []string{}
+	for _, c := range conns {
+		result, err := c.request("LIST_SUBSCRIPTIONS", nil)
+		if err != nil {
+			return nil, err
+		}
+		var streams []string
+		if err := json.Unmarshal(result, &streams); err != nil {
+			return nil, err
+		}
+		res = append(res, streams...)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// Streams return the subscribed streams, ordered by name
+func (m *StreamMux) Streams() []string {
+	m.mu.RLock()
+	res := make([]string, 0, len(m.routes))
+	for stream := range m.routes {
+		res = append(res, stream)
+	}
+	m.mu.RUnlock()
+	sort.Strings(res)
+	return res
+}
+
+// Connections return the number of connections
+func (m *StreamMux) Connections() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.conns)
+}
+
+// Close close the connections, the handlers are not called anymore
+func (m *StreamMux) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	close(m.done)
+	conns := m.conns
+	m.mu.Unlock()
+	for _, c := range conns {
+		c.mu.Lock()
+		if c.ws != nil {
+			c.ws.Close()
+		}
+		c.mu.Unlock()
+	}
+}
+
+// dial open a connection to the combined streams
+func (m *StreamMux) dial() (*websocket.Conn, error) {
+	proxy, err := WsProxy(m.cfg.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	dialer := websocket.Dialer{
+		Proxy:             proxy,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: true,
+	}
+	ws, _, err := dialer.Dial(m.cfg.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetReadLimit(655350)
+	return ws, nil
+}
+
+// open add a connection to the mux
+func (m *StreamMux) open() (*muxConn, error) {
+	ws, err := m.dial()
+	if err != nil {
+		return nil, err
+	}
+	c := &muxConn{
+		mux:     m,
+		streams: map[string]struct{}{},
+		ws:      ws,
+		pending: map[int64]chan muxResponse{},
+	}
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		ws.Close()
+		return nil, ErrStreamMuxClosed
+	}
+	m.conns = append(m.conns, c)
+	m.mu.Unlock()
+	if m.cfg.Reconnect != nil && m.cfg.Reconnect.OnConnected != nil {
+		m.cfg.Reconnect.OnConnected(m.cfg.URL)
+	}
+	go c.run(ws)
+	return c, nil
+}
+
+// remove drop a lost connection and its streams
+func (m *StreamMux) remove(c *muxConn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for stream := range c.streams {
+		delete(m.routes, stream)
+	}
+	m.removeConn(c)
+}
+
+// removeConn drop c from the connections, mu must be locked
+func (m *StreamMux) removeConn(c *muxConn) {
+	for i := range m.conns {
+		if m.conns[i] == c {
+			m.conns = append(m.conns[:i], m.conns[i+1:]...)
+			break
+		}
+	}
+}
+
+// isClosed report whether Close was called
+func (m *StreamMux) isClosed() bool {
+	select {
+	case <-m.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stop the reading goroutine of a connection removed from the mux, without reconnecting it
+func (c *muxConn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dropped = true
+	if c.ws != nil {
+		c.ws.Close()
+	}
+}
+
+// request send a frame and wait for its response
+func (c *muxConn) request(method string, params []string) (json.RawMessage, error) {
+	m := c.mux
+	id := atomic.AddInt64(&m.nextID, 1)
+	ch := make(chan muxResponse, 1)
+	c.mu.Lock()
+	ws := c.ws
+	if ws == nil {
+		c.mu.Unlock()
+		return nil, errMuxDisconnected
+	}
+	c.pending[id] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+	if err := c.write(ws, muxRequest{Method: method, Params: params, ID: id}); err != nil {
+		return nil, err
+	}
+	timer := time.NewTimer(m.cfg.RequestTimeout)
+	defer timer.Stop()
+	select {
+	case res := <-ch:
+		return res.result, res.err
+	case <-timer.C:
+		return nil, errors.New("stream mux: " + method + " request timeout")
+	case <-m.done:
+		return nil, ErrStreamMuxClosed
+	}
+}
+
+// write send a frame, at most MessagesPerSecond frames are sent per second
+func (c *muxConn) write(ws *websocket.Conn, req muxRequest) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	interval := time.Second / time.Duration(c.mux.cfg.MessagesPerSecond)
+	if wait := time.Until(c.lastWrite.Add(interval)); wait > 0 {
+		time.Sleep(wait)
+	}
+	c.lastWrite = time.Now()
+	return ws.WriteJSON(req)
+}
+
+// run read the messages of the connection until it is closed, then reconnect it
+func (c *muxConn) run(ws *websocket.Conn) {
+	m := c.mux
+	for ws != nil {
+		err := c.read(ws)
+		c.mu.Lock()
+		c.ws = nil
+		pending := c.pending
+		c.pending = map[int64]chan muxResponse{}
+		dropped := c.dropped
+		c.mu.Unlock()
+		for _, ch := range pending {
+			ch <- muxResponse{err: errMuxDisconnected}
+		}
+		if m.isClosed() || dropped {
+			return
+		}
+		m.cfg.ErrHandler(err)
+		if m.cfg.Reconnect == nil {
+			m.remove(c)
+			return
+		}
+		ws = c.reconnect(err)
+	}
+}
+
+// read dispatch the messages of ws until an error occurs
+func (c *muxConn) read(ws *websocket.Conn) error {
+	m := c.mux
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			return err
+		}
+		var msg muxMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			m.cfg.ErrHandler(err)
+			continue
+		}
+		if msg.ID != nil || msg.Error != nil {
+			c.respond(msg)
+			continue
+		}
+		m.mu.RLock()
+		r, ok := m.routes[msg.Stream]
+		var handler StreamHandler
+		if ok && r.conn == c {
+			handler = r.handler
+		}
+		m.mu.RUnlock()
+		if handler != nil {
+			handler(msg.Stream, msg.Data)
+		}
+	}
+}
+
+// respond pass a response to its request, the errors without a request go to the ErrHandler
+func (c *muxConn) respond(msg muxMessage) {
+	var res muxResponse
+	if msg.Error != nil {
+		res.err = msg.Error
+	} else {
+		res.result = msg.Result
+	}
+	var ch chan muxResponse
+	if msg.ID != nil {
+		c.mu.Lock()
+		ch = c.pending[*msg.ID]
+		delete(c.pending, *msg.ID)
+		c.mu.Unlock()
+	}
+	if ch != nil {
+		ch <- res
+	} else if res.err != nil {
+		c.mux.cfg.ErrHandler(res.err)
+	}
+}
+
+// reconnect open the connection again and subscribe its streams, it returns nil when the
+// mux was closed or the attempts ran out, the streams of the connection are then removed
+func (c *muxConn) reconnect(lastErr error) *websocket.Conn {
+	m := c.mux
+	r := m.cfg.Reconnect
+	disconnected := time.Now()
+	if r.OnDisconnected != nil {
+		r.OnDisconnected(m.cfg.URL, lastErr)
+	}
+	b := r.backoff()
+	for attempt := 1; r.MaxAttempts <= 0 || attempt <= r.MaxAttempts; attempt++ {
+		delay := b.Duration()
+		if r.OnReconnecting != nil {
+			r.OnReconnecting(m.cfg.URL, attempt, delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-m.done:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		ws, err := m.dial()
+		if err != nil {
+			m.cfg.ErrHandler(err)
+			continue
+		}
+		if err := c.resubscribe(ws); err != nil {
+			ws.Close()
+			if m.isClosed() {
+				return nil
+			}
+			m.cfg.ErrHandler(err)
+			continue
+		}
+		if r.OnConnected != nil {
+			r.OnConnected(m.cfg.URL)
+		}
+		if r.OnGap != nil {
+			r.OnGap(m.cfg.URL, disconnected, time.Now())
+		}
+		return ws
+	}
+	m.remove(c)
+	return nil
+}
+
+// resubscribe make ws the connection of c and subscribe its streams, the response is read by run
+func (c *muxConn) resubscribe(ws *websocket.Conn) error {
+	m := c.mux
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+	m.mu.RLock()
+	streams := make([]string, 0, len(c.streams))
+	for stream := range c.streams {
+		streams = append(streams, stream)
+	}
+	m.mu.RUnlock()
+	if m.isClosed() {
+		return ErrStreamMuxClosed
+	}
+	if len(streams) > 0 {
+		sort.Strings(streams)
+		id := atomic.AddInt64(&m.nextID, 1)
+		if err := c.write(ws, muxRequest{Method: "SUBSCRIBE", Params: streams, ID: id}); err != nil {
+			return err
+		}
+	}
+	c.mu.Lock()
+	c.ws = ws
+	c.mu.Unlock()
+	// Close may have missed ws
+	if m.isClosed() {
+		return ErrStreamMuxClosed
+	}
+	return nil
+}
+
+// JSONStreamHandler return a StreamHandler decoding the data of the messages into a new T,
+// the decoding errors are passed to errHandler
+func JSONStreamHandler[T any](handler func(event *T), errHandler func(err error)) StreamHandler {
+	return func(stream string, data []byte) {
+		event := new(T)
+		if err := json.Unmarshal(data, event); err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+}
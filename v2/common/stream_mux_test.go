@@ -0,0 +1,278 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// muxServer serve combined streams, the streams named "invalid" are rejected
+type muxServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	conns  []*muxServerConn
+	frames chan muxRequest
+}
+
+type muxServerConn struct {
+	ws      *websocket.Conn
+	mu      sync.Mutex
+	streams map[string]bool
+}
+
+func newMuxServer() *muxServer {
+	s := &muxServer{frames: make(chan muxRequest, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := &muxServerConn{ws: ws, streams: map[string]bool{}}
+		s.mu.Lock()
+		s.conns = append(s.conns, c)
+		s.mu.Unlock()
+		defer ws.Close()
+		for {
+			var req muxRequest
+			if err := ws.ReadJSON(&req); err != nil {
+				return
+			}
+			s.frames <- req
+			c.mu.Lock()
+			var res interface{} = map[string]interface{}{"result": nil, "id": req.ID}
+			switch req.Method {
+			case "SUBSCRIBE":
+				for _, stream := range req.Params {
+					if stream == "invalid" {
+						res = map[string]interface{}{"error": map[string]interface{}{"code": 2, "msg": "Invalid request"}, "id": req.ID}
+						break
+					}
+					c.streams[stream] = true
+				}
+			case "UNSUBSCRIBE":
+				for _, stream := range req.Params {
+					delete(c.streams, stream)
+				}
+			case "LIST_SUBSCRIPTIONS":
+				streams := []string{}
+				for stream := range c.streams {
+					streams = append(streams, stream)
+				}
+				sort.Strings(streams)
+				res = map[string]interface{}{"result": streams, "id": req.ID}
+			}
+			c.ws.WriteJSON(res)
+			c.mu.Unlock()
+		}
+	}))
+	return s
+}
+
+func (s *muxServer) url() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/stream"
+}
+
+func (s *muxServer) conn(i int) *muxServerConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[i]
+}
+
+// push send a message on the connections subscribed to stream
+func (s *muxServer) push(stream string, data string) {
+	s.mu.Lock()
+	conns := append([]*muxServerConn(nil), s.conns...)
+	s.mu.Unlock()
+	for _, c := range conns {
+		c.mu.Lock()
+		if c.streams[stream] {
+			c.ws.WriteMessage(websocket.TextMessage, []byte(`{"stream":"`+stream+`","data":`+data+`}`))
+		}
+		c.mu.Unlock()
+	}
+}
+
+type muxMessages chan string
+
+func (m muxMessages) handler(stream string, data []byte) {
+	m <- stream + " " + string(data)
+}
+
+func (m muxMessages) next(t *testing.T) string {
+	select {
+	case msg := <-m:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message")
+		return ""
+	}
+}
+
+func TestStreamMuxSharding(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	mux := NewStreamMux(StreamMuxConfig{URL: server.url(), MaxStreams: 2, MessagesPerSecond: 100})
+	defer mux.Close()
+
+	messages := make(muxMessages, 10)
+	require.NoError(t, mux.Subscribe(messages.handler, "a@trade", "b@trade", "c@trade"))
+	assert.Equal(t, 2, mux.Connections())
+	assert.Equal(t, []string{"a@trade", "b@trade", "c@trade"}, mux.Streams())
+
+	server.push("c@trade", `{"p":"1"}`)
+	assert.Equal(t, `c@trade {"p":"1"}`, messages.next(t))
+	server.push("a@trade", `{"p":"2"}`)
+	assert.Equal(t, `a@trade {"p":"2"}`, messages.next(t))
+
+	// the spare capacity of the first connections is used before opening a new one
+	require.NoError(t, mux.Unsubscribe("b@trade", "unknown"))
+	require.NoError(t, mux.Subscribe(messages.handler, "d@trade"))
+	assert.Equal(t, 2, mux.Connections())
+	streams, err := mux.ListSubscriptions()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@trade", "c@trade", "d@trade"}, streams)
+}
+
+func TestStreamMuxReplaceHandler(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	mux := NewStreamMux(StreamMuxConfig{URL: server.url(), MessagesPerSecond: 100})
+	defer mux.Close()
+
+	first, second := make(muxMessages, 1), make(muxMessages, 1)
+	require.NoError(t, mux.Subscribe(first.handler, "a@trade"))
+	require.NoError(t, mux.Subscribe(second.handler, "a@trade"))
+	assert.Equal(t, "SUBSCRIBE", (<-server.frames).Method)
+	assert.Len(t, server.frames, 0)
+
+	server.push("a@trade", `{}`)
+	assert.Equal(t, "a@trade {}", second.next(t))
+	assert.Len(t, first, 0)
+}
+
+func TestStreamMuxRejected(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	mux := NewStreamMux(StreamMuxConfig{URL: server.url(), MessagesPerSecond: 100})
+	defer mux.Close()
+
+	err := mux.Subscribe(func(stream string, data []byte) {}, "a@trade", "invalid")
+	apiErr, ok := err.(*APIError)
+	require.True(t, ok, err)
+	assert.Equal(t, int64(2), apiErr.Code)
+	assert.Empty(t, mux.Streams())
+}
+
+func TestStreamMuxRejectedConnection(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	errs := make(chan error, 10)
+	mux := NewStreamMux(StreamMuxConfig{
+		URL:               server.url(),
+		MessagesPerSecond: 100,
+		Reconnect:         &WsReconnect{MinDelay: time.Millisecond},
+		ErrHandler:        func(err error) { errs <- err },
+	})
+	defer mux.Close()
+
+	// the connection opened for the rejected streams is closed and not reconnected
+	assert.Error(t, mux.Subscribe(func(stream string, data []byte) {}, "invalid"))
+	assert.Equal(t, 0, mux.Connections())
+	time.Sleep(50 * time.Millisecond)
+	server.mu.Lock()
+	assert.Len(t, server.conns, 1)
+	server.mu.Unlock()
+	assert.Empty(t, errs)
+
+	require.NoError(t, mux.Subscribe(func(stream string, data []byte) {}, "a@trade"))
+	assert.Equal(t, 1, mux.Connections())
+}
+
+func TestStreamMuxRateLimit(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	mux := NewStreamMux(StreamMuxConfig{URL: server.url(), MessagesPerSecond: 10})
+	defer mux.Close()
+
+	start := time.Now()
+	for _, stream := range []string{"a@trade", "b@trade", "c@trade"} {
+		require.NoError(t, mux.Subscribe(func(stream string, data []byte) {}, stream))
+	}
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+}
+
+func TestStreamMuxReconnect(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	gaps := make(chan string, 1)
+	mux := NewStreamMux(StreamMuxConfig{
+		URL:               server.url(),
+		MessagesPerSecond: 100,
+		Reconnect: &WsReconnect{
+			MinDelay: time.Millisecond,
+			OnGap: func(endpoint string, start, end time.Time) {
+				gaps <- endpoint
+			},
+		},
+	})
+	defer mux.Close()
+
+	messages := make(muxMessages, 1)
+	require.NoError(t, mux.Subscribe(messages.handler, "a@trade", "b@trade"))
+	<-server.frames
+	server.conn(0).ws.Close()
+
+	req := <-server.frames
+	assert.Equal(t, muxRequest{Method: "SUBSCRIBE", Params: []string{"a@trade", "b@trade"}, ID: req.ID}, req)
+	assert.Equal(t, server.url(), <-gaps)
+	server.push("b@trade", `{}`)
+	assert.Equal(t, "b@trade {}", messages.next(t))
+	assert.Equal(t, 1, mux.Connections())
+}
+
+func TestStreamMuxConnectionLost(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	errs := make(chan error, 1)
+	mux := NewStreamMux(StreamMuxConfig{
+		URL:               server.url(),
+		MessagesPerSecond: 100,
+		ErrHandler:        func(err error) { errs <- err },
+	})
+	defer mux.Close()
+
+	require.NoError(t, mux.Subscribe(func(stream string, data []byte) {}, "a@trade"))
+	server.conn(0).ws.Close()
+	assert.Error(t, <-errs)
+	assert.Eventually(t, func() bool {
+		return mux.Connections() == 0
+	}, 5*time.Second, time.Millisecond)
+	assert.Empty(t, mux.Streams())
+}
+
+func TestStreamMuxClose(t *testing.T) {
+	server := newMuxServer()
+	defer server.Close()
+	mux := NewStreamMux(StreamMuxConfig{URL: server.url()})
+	mux.Close()
+	mux.Close()
+	assert.Equal(t, ErrStreamMuxClosed, mux.Subscribe(func(stream string, data []byte) {}, "a@trade"))
+	_, err := mux.ListSubscriptions()
+	assert.Equal(t, ErrStreamMuxClosed, err)
+}
+
+func TestMuxMessage(t *testing.T) {
+	var msg muxMessage
+	require.NoError(t, json.Unmarshal([]byte(`{"result":null,"id":3}`), &msg))
+	require.NotNil(t, msg.ID)
+	assert.Equal(t, int64(3), *msg.ID)
+}
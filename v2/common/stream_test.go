@@ -0,0 +1,146 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStream is a stream serving the messages sent on its handler
+type testStream struct {
+	handler    func(event string)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopped    chan struct{}
+}
+
+func (ts *testStream) serve(handler func(event string), errHandler func(err error), reconnect *WsReconnect) (doneC, stopC chan struct{}, err error) {
+	ts.handler, ts.errHandler = handler, errHandler
+	ts.doneC, stopC = make(chan struct{}), make(chan struct{})
+	ts.stopped = make(chan struct{})
+	go func() {
+		<-stopC
+		close(ts.stopped)
+		close(ts.doneC)
+	}()
+	return ts.doneC, stopC, nil
+}
+
+func nextEvent(t *testing.T, s *Stream[string]) StreamEvent[string] {
+	select {
+	case e := <-s.Events():
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+		return StreamEvent[string]{}
+	}
+}
+
+func TestStreamNext(t *testing.T) {
+	ts := &testStream{}
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := NewStream[string](ctx, nil, ts.serve)
+	require.NoError(t, err)
+	assert.Equal(t, StreamEventConnected, nextEvent(t, s).Type)
+
+	go func() {
+		ts.handler("a")
+		ts.errHandler(errors.New("read failed"))
+		ts.handler("b")
+	}()
+	for _, want := range []string{"a", "b"} {
+		event, err := s.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, event)
+	}
+	assert.NoError(t, s.Err())
+
+	cancel()
+	<-ts.stopped
+	_, err = s.Next(context.Background())
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, context.Canceled, s.Err())
+}
+
+func TestStreamEnded(t *testing.T) {
+	ts := &testStream{}
+	s, err := NewStream[string](context.Background(), nil, ts.serve)
+	require.NoError(t, err)
+	readErr := errors.New("read failed")
+	ts.errHandler(readErr)
+	close(ts.doneC)
+
+	assert.Equal(t, StreamEventConnected, nextEvent(t, s).Type)
+	e := nextEvent(t, s)
+	assert.Equal(t, StreamEventError, e.Type)
+	assert.Equal(t, readErr, e.Err)
+	_, ok := <-s.Events()
+	assert.False(t, ok)
+	assert.Equal(t, readErr, s.Err())
+}
+
+func TestStreamClose(t *testing.T) {
+	doneC := make(chan struct{})
+	s, err := NewStream[string](context.Background(), nil, func(handler func(event string), errHandler func(err error), reconnect *WsReconnect) (chan struct{}, chan struct{}, error) {
+		stopC := make(chan struct{})
+		go func() {
+			defer close(doneC)
+			// the handler blocked by the full channel is released by Close
+			for i := 0; i < 100; i++ {
+				handler("a")
+			}
+			<-stopC
+		}()
+		return doneC, stopC, nil
+	})
+	require.NoError(t, err)
+	s.Close()
+	<-doneC
+	for range s.Events() {
+	}
+	assert.Equal(t, ErrStreamClosed, s.Err())
+}
+
+func TestStreamServeError(t *testing.T) {
+	_, err := NewStream[string](context.Background(), nil, func(handler func(event string), errHandler func(err error), reconnect *WsReconnect) (doneC, stopC chan struct{}, err error) {
+		return nil, nil, errors.New("dial failed")
+	})
+	assert.EqualError(t, err, "dial failed")
+}
+
+func TestStreamReconnect(t *testing.T) {
+	f := &fakeServe{dials: make(chan *fakeConn, 10)}
+	var connected []string
+	r := &WsReconnect{
+		MinDelay:    time.Millisecond,
+		OnConnected: func(endpoint string) { connected = append(connected, endpoint) },
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s, err := NewStream[string](ctx, r, func(handler func(event string), errHandler func(err error), reconnect *WsReconnect) (doneC, stopC chan struct{}, err error) {
+		serve := ChainWsMiddleware(f.serve, reconnect.Middleware())
+		return serve("btcusdt@trade", func(message []byte) { handler(string(message)) }, errHandler)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StreamEvent[string]{Type: StreamEventConnected, Endpoint: "btcusdt@trade"}, nextEvent(t, s))
+
+	(<-f.dials).drop(errors.New("connection reset"))
+	var types []StreamEventType
+	for len(types) < 5 {
+		e := nextEvent(t, s)
+		types = append(types, e.Type)
+		if e.Type == StreamEventReconnecting {
+			assert.Equal(t, 1, e.Attempt)
+		}
+	}
+	assert.Equal(t, []StreamEventType{
+		StreamEventError, StreamEventDisconnected, StreamEventReconnecting, StreamEventConnected, StreamEventGap,
+	}, types)
+	// the callbacks of the environment are still called
+	assert.Equal(t, []string{"btcusdt@trade", "btcusdt@trade"}, connected)
+	assert.NoError(t, s.Err())
+}
@@ -0,0 +1,217 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultTimeSyncSamples is the number of server time requests made by each sync
+	defaultTimeSyncSamples = 3
+
+	// minResyncInterval prevents a burst of -1021 errors from triggering a burst of syncs
+	minResyncInterval = time.Second
+)
+
+// ServerTimeFunc return the server time in milliseconds
+type ServerTimeFunc func(ctx context.Context) (serverTime int64, err error)
+
+// TimeSync keeps time offsets in sync with the server time.
+// Each sync samples the server time several times and keeps the sample with the
+// shortest round trip, the offset is estimated against the middle of that round trip.
+// Offsets have the same meaning as Client.TimeOffset and are stored atomically
+// into every attached target.
+type TimeSync struct {
+	fetch    ServerTimeFunc
+	interval time.Duration
+	samples  int
+
+	// ErrHandler is called with the errors of background syncs
+	ErrHandler func(err error)
+
+	offset   int64
+	mu       sync.Mutex
+	syncMu   sync.Mutex
+	targets  []*int64
+	lastSync time.Time
+	stopC    chan struct{}
+	doneC    chan struct{}
+	resyncC  chan struct{}
+	now      func() time.Time
+}
+
+// NewTimeSync init a TimeSync which syncs every interval once started
+func NewTimeSync(fetch ServerTimeFunc, interval time.Duration, targets ...*int64) *TimeSync {
+	return &TimeSync{
+		fetch:    fetch,
+		interval: interval,
+		samples:  defaultTimeSyncSamples,
+		targets:  targets,
+		resyncC:  make(chan struct{}, 1),
+		now:      time.Now,
+	}
+}
+
+// Samples set the number of server time requests made by each sync
+func (s *TimeSync) Samples(samples int) *TimeSync {
+	if samples > 0 {
+		s.samples = samples
+	}
+	return s
+}
+
+// Attach add a target which is updated with the offset on every sync,
+// e.g. the TimeOffset of a client or of a websocket API service
+func (s *TimeSync) Attach(target *int64) *TimeSync {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targets = append(s.targets, target)
+	if !s.lastSync.IsZero() {
+		atomic.StoreInt64(target, atomic.LoadInt64(&s.offset))
+	}
+	return s
+}
+
+// Offset return the last estimated offset in milliseconds
+func (s *TimeSync) Offset() int64 {
+	return atomic.LoadInt64(&s.offset)
+}
+
+// LastSync return the time of the last successful sync
+func (s *TimeSync) LastSync() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSync
+}
+
+// Sync sample the server time and update the offset of all the targets
+func (s *TimeSync) Sync(ctx context.Context) (offset int64, err error) {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	return s.sync(ctx)
+}
+
+func (s *TimeSync) sync(ctx context.Context) (offset int64, err error) {
+	var best time.Duration = -1
+	for i := 0; i < s.samples; i++ {
+		start := s.now()
+		serverTime, ferr := s.fetch(ctx)
+		end := s.now()
+		if ferr != nil {
+			err = ferr
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		rtt := end.Sub(start)
+		if best >= 0 && rtt >= best {
+			continue
+		}
+		best = rtt
+		local := start.Add(rtt / 2)
+		offset = local.UnixNano()/int64(time.Millisecond) - serverTime
+	}
+	if best < 0 {
+		return 0, err
+	}
+	atomic.StoreInt64(&s.offset, offset)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSync = s.now()
+	for _, target := range s.targets {
+		atomic.StoreInt64(target, offset)
+	}
+	return offset, nil
+}
+
+// Resync sync immediately unless another sync completed within the last second
+func (s *TimeSync) Resync(ctx context.Context) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	if last := s.LastSync(); !last.IsZero() && s.now().Sub(last) < minResyncInterval {
+		return nil
+	}
+	_, err := s.sync(ctx)
+	return err
+}
+
+// HandleError resync when err reports a timestamp outside of the recvWindow (-1021),
+// it returns whether a resync was attempted
+func (s *TimeSync) HandleError(ctx context.Context, err error) bool {
+	if !errors.Is(err, ErrInvalidTimestamp) {
+		return false
+	}
+	if rerr := s.Resync(ctx); rerr != nil && s.ErrHandler != nil {
+		s.ErrHandler(rerr)
+	}
+	return true
+}
+
+// Trigger request a background sync without waiting for it
+func (s *TimeSync) Trigger() {
+	select {
+	case s.resyncC <- struct{}{}:
+	default:
+	}
+}
+
+// Start sync once and then every interval in the background until Stop is called
+func (s *TimeSync) Start() {
+	s.mu.Lock()
+	if s.stopC != nil {
+		s.mu.Unlock()
+		return
+	}
+	stopC := make(chan struct{})
+	doneC := make(chan struct{})
+	s.stopC, s.doneC = stopC, doneC
+	s.mu.Unlock()
+
+	go func() {
+		defer close(doneC)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-stopC:
+				cancel()
+			case <-doneC:
+			}
+		}()
+		var ticker *time.Ticker
+		var tickC <-chan time.Time
+		if s.interval > 0 {
+			ticker = time.NewTicker(s.interval)
+			defer ticker.Stop()
+			tickC = ticker.C
+		}
+		for {
+			if _, err := s.Sync(ctx); err != nil && ctx.Err() == nil && s.ErrHandler != nil {
+				s.ErrHandler(err)
+			}
+			select {
+			case <-stopC:
+				return
+			case <-tickC:
+			case <-s.resyncC:
+			}
+		}
+	}()
+}
+
+// Stop the background sync started by Start
+func (s *TimeSync) Stop() {
+	s.mu.Lock()
+	stopC, doneC := s.stopC, s.doneC
+	s.stopC, s.doneC = nil, nil
+	s.mu.Unlock()
+	if stopC == nil {
+		return
+	}
+	close(stopC)
+	<-doneC
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSyncSync(t *testing.T) {
+	local := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rtts := []time.Duration{80 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond}
+	calls := 0
+	s := NewTimeSync(func(ctx context.Context) (int64, error) {
+		// the server is 500ms behind, answering in the middle of the round trip
+		rtt := rtts[calls]
+		calls++
+		serverTime := local.Add(rtt/2).Add(-500*time.Millisecond).UnixNano() / int64(time.Millisecond)
+		local = local.Add(rtt)
+		return serverTime, nil
+	}, time.Minute)
+	s.now = func() time.Time { return local }
+
+	var clientOffset, wsOffset int64
+	s.Attach(&clientOffset)
+	offset, err := s.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, int64(500), offset)
+	assert.Equal(t, int64(500), s.Offset())
+	assert.Equal(t, int64(500), atomic.LoadInt64(&clientOffset))
+
+	// targets attached later get the current offset right away
+	s.Attach(&wsOffset)
+	assert.Equal(t, int64(500), atomic.LoadInt64(&wsOffset))
+}
+
+func TestTimeSyncHandleError(t *testing.T) {
+	calls := 0
+	now := time.Now()
+	s := NewTimeSync(func(ctx context.Context) (int64, error) {
+		calls++
+		return now.UnixNano() / int64(time.Millisecond), nil
+	}, 0).Samples(1)
+
+	assert.False(t, s.HandleError(context.Background(), errors.New("dummy error")))
+	assert.False(t, s.HandleError(context.Background(), &APIError{Code: -2010}))
+	assert.Equal(t, 0, calls)
+
+	assert.True(t, s.HandleError(context.Background(), &APIError{Code: -1021}))
+	assert.Equal(t, 1, calls)
+	// a burst of -1021 errors only resyncs once
+	assert.True(t, s.HandleError(context.Background(), &APIError{Code: -1021}))
+	assert.Equal(t, 1, calls)
+}
+
+func TestTimeSyncStartStop(t *testing.T) {
+	synced := make(chan struct{}, 10)
+	s := NewTimeSync(func(ctx context.Context) (int64, error) {
+		synced <- struct{}{}
+		return time.Now().UnixNano() / int64(time.Millisecond), nil
+	}, time.Hour).Samples(1)
+	s.Start()
+	<-synced
+	s.Trigger()
+	<-synced
+	s.Stop()
+	assert.False(t, s.LastSync().IsZero())
+}
@@ -4,15 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"log"
-	"os"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/jpillora/backoff"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 //go:generate mockgen -source client.go -destination mock/client.go -package mock
@@ -23,6 +22,9 @@ const (
 
 	// reconnectMaxInterval define reconnect max interval
 	reconnectMaxInterval = 10 * time.Second
+
+	// defaultKeepaliveTimeout define the keepalive timeout of the connections created without a positive one
+	defaultKeepaliveTimeout = 60 * time.Second
 )
 
 var (
@@ -43,8 +45,7 @@ type messageId struct {
 
 // client define API websocket client
 type client struct {
-	Debug                       bool
-	logger                      *log.Logger
+	logger                      common.Logger
 	conn                        Connection
 	connMu                      sync.Mutex
 	reconnectSignal             chan struct{}
@@ -55,16 +56,23 @@ type client struct {
 	reconnectCount              int64
 }
 
-func (c *client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.logger.Println(fmt.Sprintf(format, v...))
+// ClientOption define option of the client
+type ClientOption func(c *client)
+
+// WithLogger set the structured logger of the client, the apiKey and signature
+// parameters of the logged requests are redacted. Nothing is logged by default.
+func WithLogger(logger common.Logger) ClientOption {
+	return func(c *client) {
+		if logger != nil {
+			c.logger = logger
+		}
 	}
 }
 
 // NewClient init client
-func NewClient(conn Connection) (Client, error) {
+func NewClient(conn Connection, opts ...ClientOption) (Client, error) {
 	client := &client{
-		logger:                      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		logger:                      common.DiscardLogger,
 		conn:                        conn,
 		connMu:                      sync.Mutex{},
 		reconnectSignal:             make(chan struct{}, 1),
@@ -73,6 +81,9 @@ func NewClient(conn Connection) (Client, error) {
 		readErrChan:                 make(chan error, 1),
 		readC:                       make(chan []byte),
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
 
 	go client.handleReconnect()
 	go client.read()
@@ -99,9 +110,10 @@ func (c *client) Write(id string, data []byte) error {
 	}
 
 	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write: unable to write message into websocket conn '%v'", err)
+		c.logger.Warn("binance ws write failed", common.LogKeyRequestID, id, common.LogKeyError, err)
 		return err
 	}
+	c.logger.Debug("binance ws request", common.LogKeyRequestID, id, "payload", common.Redact(string(data)))
 
 	c.requestsList.Add(id)
 
@@ -115,9 +127,11 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 	defer c.connMu.Unlock()
 
 	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write sync: unable to write message into websocket conn '%v'", err)
+		c.logger.Warn("binance ws write failed", common.LogKeyRequestID, id, common.LogKeyError, err)
 		return nil, err
 	}
+	c.logger.Debug("binance ws request", common.LogKeyRequestID, id, "payload", common.Redact(string(data)))
+	start := time.Now()
 
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -125,7 +139,7 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 	for {
 		select {
 		case <-ctx.Done():
-			c.debug("write sync: timeout expired")
+			c.logger.Warn("binance ws request timeout", common.LogKeyRequestID, id, common.LogKeyLatency, time.Since(start))
 			return nil, ErrorWsReadConnectionTimeout
 		case rawData := <-c.readC:
 			// check that the correct response from websocket has been read
@@ -135,13 +149,14 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 				return nil, err
 			}
 			if msg.Id != id {
-				c.debug("write sync: wrong response with id '%v' has been read", msg.Id)
+				c.logger.Debug("binance ws unexpected response", common.LogKeyRequestID, msg.Id)
 				continue
 			}
+			c.logger.Debug("binance ws response", common.LogKeyRequestID, id, common.LogKeyLatency, time.Since(start))
 
 			return rawData, nil
 		case err := <-c.readErrChan:
-			c.debug("write sync: error read '%v'", err)
+			c.logger.Warn("binance ws read failed", common.LogKeyRequestID, id, common.LogKeyError, err)
 			return nil, err
 		}
 	}
@@ -169,36 +184,30 @@ func (c *client) read() {
 	}()
 
 	for {
-		c.debug("read: waiting for message")
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.debug("read: error reading message '%v'", err)
+			c.logger.Warn("binance ws read failed", common.LogKeyError, err)
 			c.reconnectSignal <- struct{}{}
 			c.readErrChan <- err
 
-			c.debug("read: wait to get connected")
 			<-c.connectionEstablishedSignal
 
 			// refresh map after reconnect to avoid useless waiting after stop application
 			c.requestsList.RecreateList()
 
-			c.debug("read: connection established")
 			continue
 		}
-		c.debug("read: got new message")
-
 		msg := messageId{}
 		err = json.Unmarshal(message, &msg)
 		if err != nil {
-			c.debug("read: error unmarshalling message '%v'", err)
+			c.logger.Warn("binance ws invalid message", common.LogKeyError, err)
 			c.readErrChan <- err
 			continue
 		}
 
-		c.debug("read: sending message into read channel '%v'", msg)
+		c.logger.Debug("binance ws message", common.LogKeyRequestID, msg.Id)
 		c.readC <- message
 
-		c.debug("read: remove message from request list '%v'", msg)
 		c.requestsList.Remove(msg.Id)
 	}
 }
@@ -236,7 +245,7 @@ func (c *client) wait(timeout time.Duration) {
 // handleReconnect waits for reconnect signal and starts reconnect
 func (c *client) handleReconnect() {
 	for _ = range c.reconnectSignal {
-		c.debug("reconnect: received signal")
+		c.logger.Info("binance ws reconnecting")
 
 		b := &backoff.Backoff{
 			Min:    reconnectMinInterval,
@@ -253,7 +262,7 @@ func (c *client) handleReconnect() {
 		c.conn = conn
 		c.connMu.Unlock()
 
-		c.debug("reconnect: connected")
+		c.logger.Info("binance ws reconnected", "reconnect_count", c.GetReconnectCount())
 		c.connectionEstablishedSignal <- struct{}{}
 	}
 }
@@ -265,7 +274,7 @@ func (c *client) startReconnect(b *backoff.Backoff) Connection {
 		conn, err := c.conn.RestoreConnection()
 		if err != nil {
 			delay := b.Duration()
-			c.debug("reconnect: error while reconnecting. try in %s", delay.Round(time.Millisecond))
+			c.logger.Warn("binance ws reconnect failed", common.LogKeyError, err, "retry_in", delay.Round(time.Millisecond))
 			time.Sleep(delay)
 			continue
 		}
@@ -343,6 +352,10 @@ func NewConnection(
 		return nil, err
 	}
 
+	if keepaliveTimeout <= 0 {
+		keepaliveTimeout = defaultKeepaliveTimeout
+	}
+
 	wsConn := &connection{
 		conn:                   underlyingWsConn,
 		connectionMu:           sync.Mutex{},
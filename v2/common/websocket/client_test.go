@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -256,3 +262,82 @@ func startWsTestServer(stopCh chan struct{}) {
 	}
 	log.Println("Graceful shutdown complete.")
 }
+
+// echoConnection return the written messages as responses
+type echoConnection struct {
+	messages chan []byte
+}
+
+func (c *echoConnection) WriteMessage(messageType int, data []byte) error {
+	c.messages <- data
+	return nil
+}
+
+func (c *echoConnection) ReadMessage() (int, []byte, error) {
+	return websocket.TextMessage, <-c.messages, nil
+}
+
+func (c *echoConnection) RestoreConnection() (Connection, error) {
+	return c, nil
+}
+
+type testLogger struct {
+	mu      sync.Mutex
+	records []string
+}
+
+func (l *testLogger) record(level, msg string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, strings.TrimSpace(fmt.Sprintln(append([]interface{}{level, msg}, args...)...)))
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.record("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.record("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.record("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.record("ERROR", msg, args) }
+
+func TestClientLogger(t *testing.T) {
+	logger := &testLogger{}
+	c, err := NewClient(&echoConnection{messages: make(chan []byte, 1)}, WithLogger(logger))
+	require.NoError(t, err)
+
+	data := []byte(`{"id":"request-1","method":"order.place","params":{"apiKey":"secretAPIKey","signature":"secretSignature"}}`)
+	rsp, err := c.WriteSync("request-1", data, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, data, rsp)
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	require.NotEmpty(t, logger.records)
+	assert.Contains(t, logger.records[0], "DEBUG binance ws request request_id request-1 payload")
+	assert.Contains(t, logger.records[0], `"apiKey":"[REDACTED]"`)
+	for _, r := range logger.records {
+		assert.NotContains(t, r, "secretAPIKey")
+		assert.NotContains(t, r, "secretSignature")
+	}
+}
+
+func TestNewConnectionKeepaliveWithoutTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	conn, err := NewConnection(func() (*websocket.Conn, error) {
+		c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		return c, err
+	}, true, 0)
+	require.NoError(t, err)
+	defer conn.(*connection).close()
+	assert.Equal(t, defaultKeepaliveTimeout, conn.(*connection).keepaliveTimeout)
+}
@@ -58,6 +58,9 @@ var (
 
 	// ErrorSecretKeyIsNotSet defines that SecretKey is not set
 	ErrorSecretKeyIsNotSet = errors.New("ws service: secret key is not set")
+
+	// ErrorKeyTypeIsNotSet defines that KeyType is not set
+	ErrorKeyTypeIsNotSet = errors.New("ws service: key type is not set")
 )
 
 func NewRequestData(
@@ -82,6 +85,14 @@ type RequestData struct {
 	secretKey  string
 	timeOffset int64
 	keyType    string
+	signer     common.Signer
+}
+
+// WithSigner sign the request with signer instead of the secret key and the key type,
+// the secret key may be empty then
+func (d RequestData) WithSigner(signer common.Signer) RequestData {
+	d.signer = signer
+	return d
 }
 
 // CreateRequest creates signed ws request
@@ -94,18 +105,25 @@ func CreateRequest(reqData RequestData, method WsApiMethodType, params map[strin
 		return nil, ErrorApiKeyIsNotSet
 	}
 
-	if reqData.secretKey == "" {
-		return nil, ErrorSecretKeyIsNotSet
+	signer := reqData.signer
+	if signer == nil {
+		if reqData.secretKey == "" {
+			return nil, ErrorSecretKeyIsNotSet
+		}
+		if reqData.keyType == "" {
+			return nil, ErrorKeyTypeIsNotSet
+		}
+		var err error
+		signer, err = common.NewSigner(reqData.keyType, reqData.secretKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	params[apiKey] = reqData.apiKey
 	params[timestampKey] = timestamp(reqData.timeOffset)
 
-	sf, err := common.SignFunc(reqData.keyType)
-	if err != nil {
-		return nil, err
-	}
-	signature, err := sf(reqData.secretKey, encodeParams(params))
+	signature, err := signer.Sign(encodeParams(params))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,38 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestCreateRequestWithSigner(t *testing.T) {
+	var payload string
+	signer := common.SignerFunc(func(p string) (string, error) {
+		payload = p
+		return "remote-signature", nil
+	})
+	reqData := NewRequestData("id", "apiKey", "", 0, "").WithSigner(signer)
+	rawData, err := CreateRequest(reqData, OrderPlaceSpotWsApiMethod, map[string]interface{}{"symbol": "BTCUSDT"})
+	require.NoError(t, err)
+
+	req := new(WsApiRequest)
+	require.NoError(t, json.Unmarshal(rawData, req))
+	assert.Equal(t, "remote-signature", req.Params[signatureKey])
+	assert.Contains(t, payload, "apiKey=apiKey&symbol=BTCUSDT&timestamp=")
+
+	_, err = CreateRequest(NewRequestData("id", "apiKey", "", 0, ""), OrderPlaceSpotWsApiMethod, map[string]interface{}{})
+	assert.ErrorIs(t, err, ErrorSecretKeyIsNotSet)
+}
+
+func TestCreateRequestKeyType(t *testing.T) {
+	_, err := CreateRequest(NewRequestData("id", "apiKey", "secretKey", 0, ""), OrderPlaceSpotWsApiMethod, map[string]interface{}{})
+	assert.ErrorIs(t, err, ErrorKeyTypeIsNotSet)
+
+	_, err = CreateRequest(NewRequestData("id", "apiKey", "secretKey", 0, "dummy"), OrderPlaceSpotWsApiMethod, map[string]interface{}{})
+	assert.EqualError(t, err, "unsupported keyType=dummy")
+}
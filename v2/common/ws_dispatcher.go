@@ -0,0 +1,299 @@
+package common
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OverflowPolicy define what a WsDispatcher does with a message when the queue of its stream is full
+type OverflowPolicy string
+
+// Overflow policies
+const (
+	// OverflowBlock wait for the handler, the reading of the connection stalls meanwhile
+	OverflowBlock OverflowPolicy = "BLOCK"
+	// OverflowDropOldest drop the oldest queued message
+	OverflowDropOldest OverflowPolicy = "DROP_OLDEST"
+	// OverflowDropNewest drop the received message
+	OverflowDropNewest OverflowPolicy = "DROP_NEWEST"
+	// OverflowConflate replace the queued message with the same key, e.g. the ticker of the same symbol,
+	// the oldest message is dropped when the queue is full of other keys
+	OverflowConflate OverflowPolicy = "CONFLATE"
+)
+
+// WsDispatcher move the handlers of the streams out of their reading goroutine, the messages wait in a
+// bounded queue per stream and are handled in order by a goroutine of the stream
+type WsDispatcher struct {
+	// QueueSize is the number of messages waiting for the handler of a stream, 1024 by default
+	QueueSize int
+	// Overflow is the policy of the full queues, OverflowBlock by default
+	Overflow OverflowPolicy
+	// Key return the conflation key of a message, WsMessageKey by default
+	Key func(message []byte) string
+
+	mu     sync.Mutex
+	queues map[*dispatchQueue]struct{}
+}
+
+// DispatchStats define the counters of the queue of a stream
+type DispatchStats struct {
+	Endpoint   string
+	QueueDepth int
+	// Dropped is the number of messages dropped by OverflowDropOldest, OverflowDropNewest and the full
+	// queues of OverflowConflate, Conflated the number of messages replaced by a newer one
+	Dropped   int64
+	Conflated int64
+	Handled   int64
+	// HandlerTime is the time spent in the handler, MaxHandlerTime its longest call
+	HandlerTime    time.Duration
+	MaxHandlerTime time.Duration
+}
+
+// WsMessageKey return the stream of a combined stream message or the symbol of a raw message,
+// an empty string when the message has neither
+func WsMessageKey(message []byte) string {
+	var m struct {
+		Stream string `json:"stream"`
+		Symbol string `json:"s"`
+	}
+	if err := json.Unmarshal(message, &m); err != nil {
+		return ""
+	}
+	if m.Stream != "" {
+		return m.Stream
+	}
+	return m.Symbol
+}
+
+// Middleware return the WsMiddleware queueing the messages of the streams. The doneC of a stream is
+// closed once the queued messages were handled, or right away when the stream is stopped.
+func (d *WsDispatcher) Middleware() WsMiddleware {
+	return func(next WsServeFunc) WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			q := d.newQueue(endpoint, handler)
+			connDone, connStop, err := next(endpoint, q.push, errHandler)
+			if err != nil {
+				return nil, nil, err
+			}
+			d.register(q)
+			doneC, stopC := make(chan struct{}), make(chan struct{})
+			workerDone := make(chan struct{})
+			go func() {
+				defer close(workerDone)
+				q.work()
+			}()
+			go func() {
+				select {
+				case <-stopC:
+					close(q.stop)
+					close(connStop)
+					<-connDone
+				case <-connDone:
+					q.end()
+				}
+				<-workerDone
+				d.unregister(q)
+				close(doneC)
+			}()
+			return doneC, stopC, nil
+		}
+	}
+}
+
+// Stats return the counters of the running streams, ordered by endpoint
+func (d *WsDispatcher) Stats() []DispatchStats {
+	d.mu.Lock()
+	queues := make([]*dispatchQueue, 0, len(d.queues))
+	for q := range d.queues {
+		queues = append(queues, q)
+	}
+	d.mu.Unlock()
+	res := make([]DispatchStats, len(queues))
+	for i, q := range queues {
+		q.mu.Lock()
+		res[i] = q.stats
+		res[i].QueueDepth = len(q.items)
+		q.mu.Unlock()
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Endpoint < res[j].Endpoint
+	})
+	return res
+}
+
+func (d *WsDispatcher) register(q *dispatchQueue) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.queues == nil {
+		d.queues = map[*dispatchQueue]struct{}{}
+	}
+	d.queues[q] = struct{}{}
+}
+
+func (d *WsDispatcher) unregister(q *dispatchQueue) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.queues, q)
+}
+
+func (d *WsDispatcher) newQueue(endpoint string, handler func(message []byte)) *dispatchQueue {
+	q := &dispatchQueue{
+		size:     d.QueueSize,
+		overflow: d.Overflow,
+		key:      d.Key,
+		handler:  handler,
+		ready:    make(chan struct{}, 1),
+		space:    make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		stats:    DispatchStats{Endpoint: endpoint},
+	}
+	if q.size <= 0 {
+		q.size = 1024
+	}
+	if q.overflow == "" {
+		q.overflow = OverflowBlock
+	}
+	if q.overflow == OverflowConflate {
+		q.keys = map[string]*dispatchItem{}
+		if q.key == nil {
+			q.key = WsMessageKey
+		}
+	}
+	return q
+}
+
+// dispatchQueue is the queue of a stream
+type dispatchQueue struct {
+	size     int
+	overflow OverflowPolicy
+	key      func(message []byte) string
+	handler  func(message []byte)
+	// ready wake the worker up, space the blocked reader
+	ready chan struct{}
+	space chan struct{}
+	// stop is closed when the stream is stopped, the queued messages are dropped
+	stop chan struct{}
+
+	mu    sync.Mutex
+	items []*dispatchItem
+	keys  map[string]*dispatchItem
+	ended bool
+	stats DispatchStats
+}
+
+type dispatchItem struct {
+	key     string
+	message []byte
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// push queue a message read by the connection
+func (q *dispatchQueue) push(message []byte) {
+	var key string
+	if q.keys != nil {
+		key = q.key(message)
+	}
+	for {
+		q.mu.Lock()
+		if q.keys != nil {
+			if item, ok := q.keys[key]; ok {
+				item.message = message
+				q.stats.Conflated++
+				q.mu.Unlock()
+				return
+			}
+		}
+		if len(q.items) >= q.size {
+			switch q.overflow {
+			case OverflowDropNewest:
+				q.stats.Dropped++
+				q.mu.Unlock()
+				return
+			case OverflowDropOldest, OverflowConflate:
+				q.pop()
+				q.stats.Dropped++
+			default:
+				q.mu.Unlock()
+				select {
+				case <-q.space:
+					continue
+				case <-q.stop:
+					return
+				}
+			}
+		}
+		item := &dispatchItem{key: key, message: message}
+		q.items = append(q.items, item)
+		if q.keys != nil {
+			q.keys[key] = item
+		}
+		q.mu.Unlock()
+		signal(q.ready)
+		return
+	}
+}
+
+// pop remove the oldest message, mu must be locked
+func (q *dispatchQueue) pop() *dispatchItem {
+	item := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+	if q.keys != nil && q.keys[item.key] == item {
+		delete(q.keys, item.key)
+	}
+	return item
+}
+
+// end let the worker return once the queue is empty
+func (q *dispatchQueue) end() {
+	q.mu.Lock()
+	q.ended = true
+	q.mu.Unlock()
+	signal(q.ready)
+}
+
+// work call the handler with the queued messages until the stream is stopped, or ended and drained
+func (q *dispatchQueue) work() {
+	for {
+		q.mu.Lock()
+		for len(q.items) == 0 {
+			ended := q.ended
+			q.mu.Unlock()
+			if ended {
+				return
+			}
+			select {
+			case <-q.ready:
+			case <-q.stop:
+				return
+			}
+			q.mu.Lock()
+		}
+		item := q.pop()
+		q.mu.Unlock()
+		signal(q.space)
+		select {
+		case <-q.stop:
+			return
+		default:
+		}
+		start := time.Now()
+		q.handler(item.message)
+		elapsed := time.Since(start)
+		q.mu.Lock()
+		q.stats.Handled++
+		q.stats.HandlerTime += elapsed
+		if elapsed > q.stats.MaxHandlerTime {
+			q.stats.MaxHandlerTime = elapsed
+		}
+		q.mu.Unlock()
+	}
+}
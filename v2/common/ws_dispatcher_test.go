@@ -0,0 +1,176 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dispatchConn is a connection serving the messages given to send
+type dispatchConn struct {
+	handler      func(message []byte)
+	doneC, stopC chan struct{}
+}
+
+func (c *dispatchConn) serve(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+	c.handler = handler
+	c.doneC, c.stopC = make(chan struct{}), make(chan struct{})
+	go func() {
+		<-c.stopC
+		close(c.doneC)
+	}()
+	return c.doneC, c.stopC, nil
+}
+
+func (c *dispatchConn) send(messages ...string) {
+	for _, m := range messages {
+		c.handler([]byte(m))
+	}
+}
+
+// slowHandler block on its first message until release is closed
+type slowHandler struct {
+	started  chan struct{}
+	release  chan struct{}
+	messages chan string
+}
+
+func newSlowHandler() *slowHandler {
+	return &slowHandler{started: make(chan struct{}), release: make(chan struct{}), messages: make(chan string, 100)}
+}
+
+func (h *slowHandler) handle(message []byte) {
+	select {
+	case <-h.started:
+	default:
+		close(h.started)
+		<-h.release
+	}
+	h.messages <- string(message)
+}
+
+func (h *slowHandler) received(n int) []string {
+	res := make([]string, 0, n)
+	for len(res) < n {
+		select {
+		case m := <-h.messages:
+			res = append(res, m)
+		case <-time.After(5 * time.Second):
+			return res
+		}
+	}
+	return res
+}
+
+func TestWsDispatcherOverflow(t *testing.T) {
+	for _, tt := range []struct {
+		overflow  OverflowPolicy
+		want      []string
+		dropped   int64
+		conflated int64
+	}{
+		{overflow: OverflowDropNewest, want: []string{`1`, `2`, `3`}, dropped: 2},
+		{overflow: OverflowDropOldest, want: []string{`1`, `4`, `5`}, dropped: 2},
+		{overflow: OverflowConflate, want: []string{`1`, `{"s":"A","v":4}`, `{"s":"C","v":5}`}, dropped: 1, conflated: 1},
+	} {
+		t.Run(string(tt.overflow), func(t *testing.T) {
+			d := &WsDispatcher{QueueSize: 2, Overflow: tt.overflow}
+			h := newSlowHandler()
+			c := &dispatchConn{}
+			doneC, _, err := ChainWsMiddleware(c.serve, d.Middleware())("btcusdt@ticker", h.handle, func(err error) {})
+			require.NoError(t, err)
+
+			c.send(`1`)
+			<-h.started
+			if tt.overflow == OverflowConflate {
+				c.send(`{"s":"B","v":2}`, `{"s":"A","v":3}`, `{"s":"A","v":4}`, `{"s":"C","v":5}`)
+			} else {
+				c.send(`2`, `3`, `4`, `5`)
+			}
+			stats := d.Stats()
+			require.Len(t, stats, 1)
+			assert.Equal(t, "btcusdt@ticker", stats[0].Endpoint)
+			assert.Equal(t, 2, stats[0].QueueDepth)
+			assert.Equal(t, tt.dropped, stats[0].Dropped)
+			assert.Equal(t, tt.conflated, stats[0].Conflated)
+
+			close(h.release)
+			assert.Equal(t, tt.want, h.received(3))
+			// the queued messages are handled before doneC is closed
+			close(c.doneC)
+			<-doneC
+			assert.Empty(t, d.Stats())
+		})
+	}
+}
+
+func TestWsDispatcherBlock(t *testing.T) {
+	d := &WsDispatcher{QueueSize: 1}
+	h := newSlowHandler()
+	c := &dispatchConn{}
+	_, stopC, err := ChainWsMiddleware(c.serve, d.Middleware())("btcusdt@trade", h.handle, func(err error) {})
+	require.NoError(t, err)
+
+	c.send(`1`)
+	<-h.started
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		c.send(`2`, `3`)
+	}()
+	select {
+	case <-sent:
+		t.Fatal("the full queue did not block the connection")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(h.release)
+	<-sent
+	assert.Equal(t, []string{`1`, `2`, `3`}, h.received(3))
+
+	stats := d.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, int64(3), stats[0].Handled)
+	assert.Zero(t, stats[0].Dropped)
+	assert.GreaterOrEqual(t, stats[0].MaxHandlerTime, 50*time.Millisecond)
+	assert.GreaterOrEqual(t, stats[0].HandlerTime, stats[0].MaxHandlerTime)
+
+	close(stopC)
+	<-c.doneC
+}
+
+func TestWsDispatcherStop(t *testing.T) {
+	d := &WsDispatcher{QueueSize: 1}
+	h := newSlowHandler()
+	c := &dispatchConn{}
+	doneC, stopC, err := ChainWsMiddleware(c.serve, d.Middleware())("btcusdt@trade", h.handle, func(err error) {})
+	require.NoError(t, err)
+
+	c.send(`1`)
+	<-h.started
+	c.send(`2`)
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		c.send(`3`)
+	}()
+	// the stop releases the connection blocked by the full queue and drops the queued messages
+	close(stopC)
+	<-sent
+	<-c.doneC
+	close(h.release)
+	<-doneC
+	assert.Equal(t, []string{`1`}, h.received(1))
+	select {
+	case m := <-h.messages:
+		t.Fatalf("unexpected message %s", m)
+	default:
+	}
+}
+
+func TestWsMessageKey(t *testing.T) {
+	assert.Equal(t, "bnbbtc@ticker", WsMessageKey([]byte(`{"stream":"bnbbtc@ticker","data":{"s":"BNBBTC"}}`)))
+	assert.Equal(t, "BNBBTC", WsMessageKey([]byte(`{"e":"24hrTicker","s":"BNBBTC"}`)))
+	assert.Equal(t, "", WsMessageKey([]byte(`[{"s":"BNBBTC"}]`)))
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// WsReconnect define the automatic reconnection of the websocket streams with a jittered exponential backoff.
+// The callbacks receive the endpoint of the stream, they are optional.
+type WsReconnect struct {
+	// MinDelay and MaxDelay bound the delay before a reconnection, 100ms and 30s by default
+	MinDelay time.Duration
+	MaxDelay time.Duration
+	// Factor multiplies the delay after each failed attempt, 2 by default
+	Factor float64
+	// MaxAttempts is the number of consecutive failed attempts after which the stream stops, unlimited when 0
+	MaxAttempts int
+
+	// OnConnected is called when the stream is connected, the first time and after every reconnection
+	OnConnected func(endpoint string)
+	// OnDisconnected is called when the connection is lost, err is the last error of the stream
+	OnDisconnected func(endpoint string, err error)
+	// OnReconnecting is called before every attempt, after delay
+	OnReconnecting func(endpoint string, attempt int, delay time.Duration)
+	// OnGap is called after a reconnection, the messages sent between start and end were lost,
+	// e.g. a local order book must be fetched again
+	OnGap func(endpoint string, start, end time.Time)
+}
+
+// Middleware return the WsMiddleware reconnecting the streams until their stopC is closed.
+// The errors of the connections and of the failed attempts are passed to the errHandler of the stream,
+// doneC is closed when the stream is stopped or after MaxAttempts failed attempts.
+func (r WsReconnect) Middleware() WsMiddleware {
+	return func(next WsServeFunc) WsServeFunc {
+		return func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+			s := &reconnectingStream{cfg: r, next: next, endpoint: endpoint, handler: handler, errHandler: errHandler}
+			connDone, connStop, err := next(endpoint, handler, s.handleErr)
+			if err != nil {
+				return nil, nil, err
+			}
+			doneC, stopC := make(chan struct{}), make(chan struct{})
+			go s.run(connDone, connStop, doneC, stopC)
+			return doneC, stopC, nil
+		}
+	}
+}
+
+// backoff return the jittered backoff of the reconnections with the default values
+func (r WsReconnect) backoff() *backoff.Backoff {
+	b := &backoff.Backoff{Min: r.MinDelay, Max: r.MaxDelay, Factor: r.Factor, Jitter: true}
+	if b.Min <= 0 {
+		b.Min = 100 * time.Millisecond
+	}
+	if b.Max <= 0 {
+		b.Max = 30 * time.Second
+	}
+	if b.Factor <= 0 {
+		b.Factor = 2
+	}
+	return b
+}
+
+// reconnectingStream is a stream opened by the WsReconnect middleware
+type reconnectingStream struct {
+	cfg        WsReconnect
+	next       WsServeFunc
+	endpoint   string
+	handler    func(message []byte)
+	errHandler func(err error)
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// handleErr keep the last error of the connection for OnDisconnected
+func (s *reconnectingStream) handleErr(err error) {
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+	s.errHandler(err)
+}
+
+func (s *reconnectingStream) run(connDone, connStop, doneC, stopC chan struct{}) {
+	defer close(doneC)
+	b := s.cfg.backoff()
+	if s.cfg.OnConnected != nil {
+		s.cfg.OnConnected(s.endpoint)
+	}
+	for {
+		select {
+		case <-stopC:
+			close(connStop)
+			<-connDone
+			return
+		case <-connDone:
+		}
+		disconnected := time.Now()
+		s.mu.Lock()
+		err := s.lastErr
+		s.lastErr = nil
+		s.mu.Unlock()
+		if s.cfg.OnDisconnected != nil {
+			s.cfg.OnDisconnected(s.endpoint, err)
+		}
+		var ok bool
+		if connDone, connStop, ok = s.reconnect(b, stopC); !ok {
+			return
+		}
+		b.Reset()
+		if s.cfg.OnConnected != nil {
+			s.cfg.OnConnected(s.endpoint)
+		}
+		if s.cfg.OnGap != nil {
+			s.cfg.OnGap(s.endpoint, disconnected, time.Now())
+		}
+	}
+}
+
+// reconnect open the stream again until it succeeds, ok is false when the stream was stopped
+// or ran out of attempts
+func (s *reconnectingStream) reconnect(b *backoff.Backoff, stopC chan struct{}) (connDone, connStop chan struct{}, ok bool) {
+	for attempt := 1; s.cfg.MaxAttempts <= 0 || attempt <= s.cfg.MaxAttempts; attempt++ {
+		delay := b.Duration()
+		if s.cfg.OnReconnecting != nil {
+			s.cfg.OnReconnecting(s.endpoint, attempt, delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-stopC:
+			timer.Stop()
+			return nil, nil, false
+		case <-timer.C:
+		}
+		connDone, connStop, err := s.next(s.endpoint, s.handler, s.handleErr)
+		if err != nil {
+			s.errHandler(err)
+			continue
+		}
+		return connDone, connStop, true
+	}
+	return nil, nil, false
+}
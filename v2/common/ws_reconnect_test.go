@@ -0,0 +1,134 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConn is a connection opened by fakeServe, drop closes it with an error
+type fakeConn struct {
+	doneC, stopC chan struct{}
+	errHandler   func(err error)
+}
+
+func (c *fakeConn) drop(err error) {
+	c.errHandler(err)
+	close(c.doneC)
+}
+
+type fakeServe struct {
+	mu    sync.Mutex
+	conns []*fakeConn
+	fails int
+	dials chan *fakeConn
+}
+
+func (f *fakeServe) serve(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fails > 0 {
+		f.fails--
+		return nil, nil, errors.New("dial failed")
+	}
+	c := &fakeConn{doneC: make(chan struct{}), stopC: make(chan struct{}), errHandler: errHandler}
+	go func() {
+		<-c.stopC
+		close(c.doneC)
+	}()
+	f.conns = append(f.conns, c)
+	f.dials <- c
+	return c.doneC, c.stopC, nil
+}
+
+func TestWsReconnect(t *testing.T) {
+	f := &fakeServe{dials: make(chan *fakeConn, 10)}
+	var mu sync.Mutex
+	var events []string
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}
+	gaps := make(chan time.Duration, 1)
+	var errs []error
+	r := WsReconnect{
+		MinDelay:       time.Millisecond,
+		MaxDelay:       5 * time.Millisecond,
+		OnConnected:    func(endpoint string) { record("connected " + endpoint) },
+		OnDisconnected: func(endpoint string, err error) { record("disconnected " + err.Error()) },
+		OnReconnecting: func(endpoint string, attempt int, delay time.Duration) {
+			assert.LessOrEqual(t, delay, 5*time.Millisecond)
+			record("reconnecting")
+		},
+		OnGap: func(endpoint string, start, end time.Time) {
+			gaps <- end.Sub(start)
+		},
+	}
+	serve := ChainWsMiddleware(f.serve, r.Middleware())
+	doneC, stopC, err := serve("btcusdt@depth", func(message []byte) {}, func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+	require.NoError(t, err)
+	conn := <-f.dials
+
+	f.mu.Lock()
+	f.fails = 1
+	f.mu.Unlock()
+	conn.drop(errors.New("closed by the server"))
+	<-f.dials
+	select {
+	case gap := <-gaps:
+		assert.True(t, gap > 0)
+	case <-time.After(time.Second):
+		t.Fatal("no gap")
+	}
+	close(stopC)
+	<-doneC
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		"connected btcusdt@depth",
+		"disconnected closed by the server",
+		"reconnecting",
+		"reconnecting",
+		"connected btcusdt@depth",
+	}, events)
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[1], "dial failed")
+	// the current connection is stopped with the stream
+	select {
+	case <-f.conns[1].doneC:
+	default:
+		t.Fatal("connection not stopped")
+	}
+}
+
+func TestWsReconnectMaxAttempts(t *testing.T) {
+	f := &fakeServe{dials: make(chan *fakeConn, 1)}
+	serve := ChainWsMiddleware(f.serve, WsReconnect{MinDelay: time.Millisecond, MaxAttempts: 2}.Middleware())
+	doneC, _, err := serve("btcusdt@trade", func(message []byte) {}, func(err error) {})
+	require.NoError(t, err)
+	conn := <-f.dials
+	f.mu.Lock()
+	f.fails = 2
+	f.mu.Unlock()
+	conn.drop(errors.New("closed"))
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		t.Fatal("stream not stopped")
+	}
+
+	// the first connection is not retried
+	f.fails = 1
+	_, _, err = serve("btcusdt@trade", func(message []byte) {}, func(err error) {})
+	assert.EqualError(t, err, "dial failed")
+}
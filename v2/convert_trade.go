@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type ConvertTradeHistoryService struct {
@@ -54,6 +58,39 @@ func (s *ConvertTradeHistoryService) Do(ctx context.Context, opts ...RequestOpti
 	return &res, nil
 }
 
+// Iterator iterate over the convert trades between StartTime and EndTime, the last 30 days by default.
+// The trades are fetched by windows of 30 days.
+func (s *ConvertTradeHistoryService) Iterator(opts ...RequestOption) *common.Iterator[ConvertTradeHistoryItem] {
+	limit := int32(maxHistoryLimit)
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	var startTime, endTime *int64
+	if s.startTime != 0 {
+		startTime = &s.startTime
+	}
+	if s.endTime != 0 {
+		endTime = &s.endTime
+	}
+	return common.NewIterator(common.TimePager[ConvertTradeHistoryItem]{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Window:    30 * 24 * time.Hour,
+		Now:       s.c.serverTimestamp,
+		Fetch: func(ctx context.Context, start, end int64) ([]ConvertTradeHistoryItem, bool, error) {
+			p := *s
+			p.startTime, p.endTime, p.limit = start, end, &limit
+			res, err := p.Do(ctx, opts...)
+			if err != nil {
+				return nil, false, err
+			}
+			return res.List, res.MoreData, nil
+		},
+		Time: func(t ConvertTradeHistoryItem) int64 { return t.CreateTime },
+		Key:  func(t ConvertTradeHistoryItem) string { return strconv.FormatInt(t.OrderId, 10) },
+	}.Pages())
+}
+
 // ConvertTradeHistory define the convert trade history
 type ConvertTradeHistory struct {
 	List      []ConvertTradeHistoryItem `json:"list"`
@@ -0,0 +1,2677 @@
+package binance
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// FromAmountDecimal set fromAmount from a decimal
+func (s *ConvertGetQuoteService) FromAmountDecimal(fromAmount decimal.Decimal) *ConvertGetQuoteService {
+	return s.FromAmount(fromAmount.String())
+}
+
+// ToAmountDecimal set toAmount from a decimal
+func (s *ConvertGetQuoteService) ToAmountDecimal(toAmount decimal.Decimal) *ConvertGetQuoteService {
+	return s.ToAmount(toAmount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *FuturesTransferService) AmountDecimal(amount decimal.Decimal) *FuturesTransferService {
+	return s.Amount(amount.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateMarginOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateMarginOrderService) QuoteOrderQtyDecimal(quoteOrderQty decimal.Decimal) *CreateMarginOrderService {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOrderService) PriceDecimal(price decimal.Decimal) *CreateMarginOrderService {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateMarginOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateMarginOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateMarginOrderService) IcebergQuantityDecimal(icebergQuantity decimal.Decimal) *CreateMarginOrderService {
+	return s.IcebergQuantity(icebergQuantity.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOCOService) QuantityDecimal(quantity decimal.Decimal) *CreateMarginOCOService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOCOService) PriceDecimal(price decimal.Decimal) *CreateMarginOCOService {
+	return s.Price(price.String())
+}
+
+// LimitIcebergQuantityDecimal set limitIcebergQty from a decimal
+func (s *CreateMarginOCOService) LimitIcebergQuantityDecimal(limitIcebergQty decimal.Decimal) *CreateMarginOCOService {
+	return s.LimitIcebergQuantity(limitIcebergQty.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateMarginOCOService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateMarginOCOService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// StopLimitPriceDecimal set stopLimitPrice from a decimal
+func (s *CreateMarginOCOService) StopLimitPriceDecimal(stopLimitPrice decimal.Decimal) *CreateMarginOCOService {
+	return s.StopLimitPrice(stopLimitPrice.String())
+}
+
+// StopIcebergQtyDecimal set stopIcebergQty from a decimal
+func (s *CreateMarginOCOService) StopIcebergQtyDecimal(stopIcebergQty decimal.Decimal) *CreateMarginOCOService {
+	return s.StopIcebergQty(stopIcebergQty.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *MarginTransferService) AmountDecimal(amount decimal.Decimal) *MarginTransferService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *MarginLoanService) AmountDecimal(amount decimal.Decimal) *MarginLoanService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *MarginRepayService) AmountDecimal(amount decimal.Decimal) *MarginRepayService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *MarginBorrowRepayService) AmountDecimal(amount decimal.Decimal) *MarginBorrowRepayService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *IsolatedMarginTransferService) AmountDecimal(amount decimal.Decimal) *IsolatedMarginTransferService {
+	return s.Amount(amount.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateOrderService) QuoteOrderQtyDecimal(quoteOrderQty decimal.Decimal) *CreateOrderService {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateOrderService) IcebergQuantityDecimal(icebergQuantity decimal.Decimal) *CreateOrderService {
+	return s.IcebergQuantity(icebergQuantity.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOCOService) QuantityDecimal(quantity decimal.Decimal) *CreateOCOService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOCOService) PriceDecimal(price decimal.Decimal) *CreateOCOService {
+	return s.Price(price.String())
+}
+
+// LimitIcebergQuantityDecimal set limitIcebergQty from a decimal
+func (s *CreateOCOService) LimitIcebergQuantityDecimal(limitIcebergQty decimal.Decimal) *CreateOCOService {
+	return s.LimitIcebergQuantity(limitIcebergQty.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOCOService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOCOService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// StopLimitPriceDecimal set stopLimitPrice from a decimal
+func (s *CreateOCOService) StopLimitPriceDecimal(stopLimitPrice decimal.Decimal) *CreateOCOService {
+	return s.StopLimitPrice(stopLimitPrice.String())
+}
+
+// StopIcebergQtyDecimal set stopIcebergQty from a decimal
+func (s *CreateOCOService) StopIcebergQtyDecimal(stopIcebergQty decimal.Decimal) *CreateOCOService {
+	return s.StopIcebergQty(stopIcebergQty.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *OrderCreateWsRequest) QuantityDecimal(quantity decimal.Decimal) *OrderCreateWsRequest {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *OrderCreateWsRequest) PriceDecimal(price decimal.Decimal) *OrderCreateWsRequest {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *OrderCreateWsRequest) StopPriceDecimal(stopPrice decimal.Decimal) *OrderCreateWsRequest {
+	return s.StopPrice(stopPrice.String())
+}
+
+// IcebergQtyDecimal set icebergQty from a decimal
+func (s *OrderCreateWsRequest) IcebergQtyDecimal(icebergQty decimal.Decimal) *OrderCreateWsRequest {
+	return s.IcebergQty(icebergQty.String())
+}
+
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *OrderCreateWsRequest) QuoteOrderQtyDecimal(quoteOrderQty decimal.Decimal) *OrderCreateWsRequest {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SimpleEarnSubscribeFlexibleProductService) AmountDecimal(amount decimal.Decimal) *SimpleEarnSubscribeFlexibleProductService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SimpleEarnSubscribeLockedProductService) AmountDecimal(amount decimal.Decimal) *SimpleEarnSubscribeLockedProductService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SimpleEarnRedeemFlexibleProductService) AmountDecimal(amount decimal.Decimal) *SimpleEarnRedeemFlexibleProductService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SimpleEarnFlexibleSubscriptionPreviewService) AmountDecimal(amount decimal.Decimal) *SimpleEarnFlexibleSubscriptionPreviewService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SimpleEarnLockedSubscriptionPreviewService) AmountDecimal(amount decimal.Decimal) *SimpleEarnLockedSubscriptionPreviewService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *TransferToSubAccountService) AmountDecimal(amount decimal.Decimal) *TransferToSubAccountService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SubAccountFuturesInternalTransferService) AmountDecimal(amount decimal.Decimal) *SubAccountFuturesInternalTransferService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SubAccountMarginTransferService) AmountDecimal(amount decimal.Decimal) *SubAccountMarginTransferService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SubAccountTransferSubToMasterService) AmountDecimal(amount decimal.Decimal) *SubAccountTransferSubToMasterService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *SubAccountUniversalTransferService) AmountDecimal(amount decimal.Decimal) *SubAccountUniversalTransferService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *ManagedSubAccountWithdrawService) AmountDecimal(amount decimal.Decimal) *ManagedSubAccountWithdrawService {
+	return s.Amount(amount.String())
+}
+
+// AmountDecimal set v from a decimal
+func (s *CreateUserUniversalTransferService) AmountDecimal(v decimal.Decimal) *CreateUserUniversalTransferService {
+	return s.Amount(v.String())
+}
+
+// AmountDecimal set v from a decimal
+func (s *CreateWithdrawService) AmountDecimal(v decimal.Decimal) *CreateWithdrawService {
+	return s.Amount(v.String())
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (b *Balance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (b *Balance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.Locked)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (s *SnapshotData) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarginLevel)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotData) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAssetOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotData) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotData) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalNetAssetOfBtc)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (s *SnapshotBalances) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (s *SnapshotBalances) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Locked)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (s *SnapshotUserAssets) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (s *SnapshotUserAssets) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (s *SnapshotUserAssets) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (s *SnapshotUserAssets) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Locked)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (s *SnapshotAssets) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarginBalance)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (s *SnapshotAssets) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.WalletBalance)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (s *SnapshotPositions) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (s *SnapshotPositions) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarkPrice)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (s *SnapshotPositions) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PositionAmt)
+}
+
+// UnRealizedProfitDecimal parse UnRealizedProfit as a decimal, see common.ParseDecimal
+func (s *SnapshotPositions) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UnRealizedProfit)
+}
+
+// MinWithdrawAmountDecimal parse MinWithdrawAmount as a decimal, see common.ParseDecimal
+func (a *AssetDetail) MinWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MinWithdrawAmount)
+}
+
+// WithdrawFeeDecimal parse WithdrawFee as a decimal, see common.ParseDecimal
+func (a *AssetDetail) WithdrawFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.WithdrawFee)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (c *CoinInfo) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Free)
+}
+
+// FreezeDecimal parse Freeze as a decimal, see common.ParseDecimal
+func (c *CoinInfo) FreezeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Freeze)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (c *CoinInfo) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Locked)
+}
+
+// WithdrawFeeDecimal parse WithdrawFee as a decimal, see common.ParseDecimal
+func (n *Network) WithdrawFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(n.WithdrawFee)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (u *UserAssetRecord) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (u *UserAssetRecord) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Locked)
+}
+
+// FreezeDecimal parse Freeze as a decimal, see common.ParseDecimal
+func (u *UserAssetRecord) FreezeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Freeze)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (d *DividendResponse) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.Amount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (c *C2CRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Amount)
+}
+
+// TotalPriceDecimal parse TotalPrice as a decimal, see common.ParseDecimal
+func (c *C2CRecord) TotalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.TotalPrice)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (c *C2CRecord) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Commission)
+}
+
+// FromAmountDecimal parse FromAmount as a decimal, see common.ParseDecimal
+func (c *ConvertTradeHistoryItem) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAmount)
+}
+
+// ToAmountDecimal parse ToAmount as a decimal, see common.ParseDecimal
+func (c *ConvertTradeHistoryItem) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAmount)
+}
+
+// FromAssetMinAmountDecimal parse FromAssetMinAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) FromAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAssetMinAmount)
+}
+
+// FromAssetMaxAmountDecimal parse FromAssetMaxAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) FromAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAssetMaxAmount)
+}
+
+// ToAssetMinAmountDecimal parse ToAssetMinAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) ToAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAssetMinAmount)
+}
+
+// ToAssetMaxAmountDecimal parse ToAssetMaxAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) ToAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAssetMaxAmount)
+}
+
+// ToAmountDecimal parse ToAmount as a decimal, see common.ParseDecimal
+func (c *ConvertQuote) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAmount)
+}
+
+// FromAmountDecimal parse FromAmount as a decimal, see common.ParseDecimal
+func (c *ConvertQuote) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAmount)
+}
+
+// FromAmountDecimal parse FromAmount as a decimal, see common.ParseDecimal
+func (c *ConvertOrderStatus) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAmount)
+}
+
+// ToAmountDecimal parse ToAmount as a decimal, see common.ParseDecimal
+func (c *ConvertOrderStatus) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAmount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (d *Deposit) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.Amount)
+}
+
+// TotalTransferedAmountDecimal parse TotalTransferedAmount as a decimal, see common.ParseDecimal
+func (u *UserAssetDribblet) TotalTransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.TotalTransferedAmount)
+}
+
+// TotalServiceChargeAmountDecimal parse TotalServiceChargeAmount as a decimal, see common.ParseDecimal
+func (u *UserAssetDribblet) TotalServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.TotalServiceChargeAmount)
+}
+
+// ServiceChargeAmountDecimal parse ServiceChargeAmount as a decimal, see common.ParseDecimal
+func (u *UserAssetDribbletDetail) ServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.ServiceChargeAmount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (u *UserAssetDribbletDetail) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Amount)
+}
+
+// TransferedAmountDecimal parse TransferedAmount as a decimal, see common.ParseDecimal
+func (u *UserAssetDribbletDetail) TransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.TransferedAmount)
+}
+
+// TotalServiceChargeDecimal parse TotalServiceCharge as a decimal, see common.ParseDecimal
+func (d *DustTransferResponse) TotalServiceChargeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TotalServiceCharge)
+}
+
+// TotalTransferedDecimal parse TotalTransfered as a decimal, see common.ParseDecimal
+func (d *DustTransferResponse) TotalTransferedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TotalTransfered)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (d *DustTransferResult) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.Amount)
+}
+
+// ServiceChargeAmountDecimal parse ServiceChargeAmount as a decimal, see common.ParseDecimal
+func (d *DustTransferResult) ServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.ServiceChargeAmount)
+}
+
+// TransferedAmountDecimal parse TransferedAmount as a decimal, see common.ParseDecimal
+func (d *DustTransferResult) TransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TransferedAmount)
+}
+
+// AmountFreeDecimal parse AmountFree as a decimal, see common.ParseDecimal
+func (l *ListDustDetail) AmountFreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.AmountFree)
+}
+
+// TotalTransferBtcDecimal parse TotalTransferBtc as a decimal, see common.ParseDecimal
+func (l *ListDustResponse) TotalTransferBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.TotalTransferBtc)
+}
+
+// TotalTransferBNBDecimal parse TotalTransferBNB as a decimal, see common.ParseDecimal
+func (l *ListDustResponse) TotalTransferBNBDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.TotalTransferBNB)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.StepSize)
+}
+
+// MaxPriceDecimal parse MaxPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxPrice)
+}
+
+// MinPriceDecimal parse MinPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MinPrice)
+}
+
+// TickSizeDecimal parse TickSize as a decimal, see common.ParseDecimal
+func (p *PriceFilter) TickSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.TickSize)
+}
+
+// BidMultiplierUpDecimal parse BidMultiplierUp as a decimal, see common.ParseDecimal
+func (p *PercentPriceBySideFilter) BidMultiplierUpDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BidMultiplierUp)
+}
+
+// BidMultiplierDownDecimal parse BidMultiplierDown as a decimal, see common.ParseDecimal
+func (p *PercentPriceBySideFilter) BidMultiplierDownDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BidMultiplierDown)
+}
+
+// AskMultiplierUpDecimal parse AskMultiplierUp as a decimal, see common.ParseDecimal
+func (p *PercentPriceBySideFilter) AskMultiplierUpDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.AskMultiplierUp)
+}
+
+// AskMultiplierDownDecimal parse AskMultiplierDown as a decimal, see common.ParseDecimal
+func (p *PercentPriceBySideFilter) AskMultiplierDownDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.AskMultiplierDown)
+}
+
+// MinNotionalDecimal parse MinNotional as a decimal, see common.ParseDecimal
+func (n *NotionalFilter) MinNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(n.MinNotional)
+}
+
+// MaxNotionalDecimal parse MaxNotional as a decimal, see common.ParseDecimal
+func (n *NotionalFilter) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(n.MaxNotional)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.StepSize)
+}
+
+// IndicatedAmountDecimal parse IndicatedAmount as a decimal, see common.ParseDecimal
+func (f *FiatDepositWithdrawHistoryItem) IndicatedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.IndicatedAmount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (f *FiatDepositWithdrawHistoryItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Amount)
+}
+
+// TotalFeeDecimal parse TotalFee as a decimal, see common.ParseDecimal
+func (f *FiatDepositWithdrawHistoryItem) TotalFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalFee)
+}
+
+// SourceAmountDecimal parse SourceAmount as a decimal, see common.ParseDecimal
+func (f *FiatPaymentsHistoryItem) SourceAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.SourceAmount)
+}
+
+// ObtainAmountDecimal parse ObtainAmount as a decimal, see common.ParseDecimal
+func (f *FiatPaymentsHistoryItem) ObtainAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.ObtainAmount)
+}
+
+// TotalFeeDecimal parse TotalFee as a decimal, see common.ParseDecimal
+func (f *FiatPaymentsHistoryItem) TotalFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalFee)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (f *FiatPaymentsHistoryItem) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Price)
+}
+
+// TotalQuantityDecimal parse TotalQuantity as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoOrder) TotalQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.ExecutedQuantity)
+}
+
+// ExecutedAmountDecimal parse ExecutedAmount as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoOrder) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.ExecutedAmount)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AvgPrice)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoSubOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.ExecutedQuantity)
+}
+
+// ExecutedAmountDecimal parse ExecutedAmount as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoSubOrder) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.ExecutedAmount)
+}
+
+// FeeAmountDecimal parse FeeAmount as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoSubOrder) FeeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.FeeAmount)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoSubOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AvgPrice)
+}
+
+// OriginQuantityDecimal parse OriginQuantity as a decimal, see common.ParseDecimal
+func (f *FuturesAlgoSubOrder) OriginQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.OriginQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (g *GetFuturesAlgoSubOrdersResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.ExecutedQuantity)
+}
+
+// ExecutedAmountDecimal parse ExecutedAmount as a decimal, see common.ParseDecimal
+func (g *GetFuturesAlgoSubOrdersResponse) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.ExecutedAmount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (f *FuturesTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Amount)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (i *InterestHistoryElement) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Interest)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (i *InternalUniversalTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Amount)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal parse QuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal parse TakerBuyBaseAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal parse TakerBuyQuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// ShareAmountDecimal parse ShareAmount as a decimal, see common.ParseDecimal
+func (p *PoolShareInformation) ShareAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.ShareAmount)
+}
+
+// QuoteAmtDecimal parse QuoteAmt as a decimal, see common.ParseDecimal
+func (a *AddLiquidityPreviewResponse) QuoteAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.QuoteAmt)
+}
+
+// BaseAmtDecimal parse BaseAmt as a decimal, see common.ParseDecimal
+func (a *AddLiquidityPreviewResponse) BaseAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.BaseAmt)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (a *AddLiquidityPreviewResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Price)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (a *AddLiquidityPreviewResponse) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Fee)
+}
+
+// QuoteQtyDecimal parse QuoteQty as a decimal, see common.ParseDecimal
+func (g *GetSwapQuoteResponse) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.QuoteQty)
+}
+
+// BaseQtyDecimal parse BaseQty as a decimal, see common.ParseDecimal
+func (g *GetSwapQuoteResponse) BaseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.BaseQty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (g *GetSwapQuoteResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.Price)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (g *GetSwapQuoteResponse) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(g.Fee)
+}
+
+// QuoteQtyDecimal parse QuoteQty as a decimal, see common.ParseDecimal
+func (s *SwapRecord) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.QuoteQty)
+}
+
+// BaseQtyDecimal parse BaseQty as a decimal, see common.ParseDecimal
+func (s *SwapRecord) BaseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.BaseQty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (s *SwapRecord) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Price)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (s *SwapRecord) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Fee)
+}
+
+// ClaimedAmountDecimal parse ClaimedAmount as a decimal, see common.ParseDecimal
+func (c *ClaimedRewardHistory) ClaimedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ClaimedAmount)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CancelMarginOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CancelMarginOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CancelMarginOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (c *CancelMarginOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CummulativeQuoteQuantity)
+}
+
+// MarginBuyBorrowAmountDecimal parse MarginBuyBorrowAmount as a decimal, see common.ParseDecimal
+func (c *CreateMarginOCOResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.MarginBuyBorrowAmount)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (m *MarginOCOOrderReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (m *MarginOCOOrderReport) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (m *MarginOCOOrderReport) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (m *MarginOCOOrderReport) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (m *MarginOCOOrderReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.StopPrice)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (m *MarginBorrowRepay) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Amount)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (m *MarginBorrowRepay) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Interest)
+}
+
+// PrincipalDecimal parse Principal as a decimal, see common.ParseDecimal
+func (m *MarginBorrowRepay) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Principal)
+}
+
+// PrincipalDecimal parse Principal as a decimal, see common.ParseDecimal
+func (m *MarginLoan) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Principal)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (m *MarginRepay) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Amount)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (m *MarginRepay) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Interest)
+}
+
+// PrincipalDecimal parse Principal as a decimal, see common.ParseDecimal
+func (m *MarginRepay) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Principal)
+}
+
+// TotalAssetOfBTCDecimal parse TotalAssetOfBTC as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAccount) TotalAssetOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.TotalAssetOfBTC)
+}
+
+// TotalLiabilityOfBTCDecimal parse TotalLiabilityOfBTC as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAccount) TotalLiabilityOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.TotalLiabilityOfBTC)
+}
+
+// TotalNetAssetOfBTCDecimal parse TotalNetAssetOfBTC as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAccount) TotalNetAssetOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.TotalNetAssetOfBTC)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAsset) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.MarginLevel)
+}
+
+// MarginRatioDecimal parse MarginRatio as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAsset) MarginRatioDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.MarginRatio)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAsset) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.IndexPrice)
+}
+
+// LiquidatePriceDecimal parse LiquidatePrice as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAsset) LiquidatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.LiquidatePrice)
+}
+
+// LiquidateRateDecimal parse LiquidateRate as a decimal, see common.ParseDecimal
+func (i *IsolatedMarginAsset) LiquidateRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.LiquidateRate)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (i *IsolatedUserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (i *IsolatedUserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (i *IsolatedUserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (i *IsolatedUserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Locked)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (m *MarginAccount) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarginLevel)
+}
+
+// CollateralMarginLevelDecimal parse CollateralMarginLevel as a decimal, see common.ParseDecimal
+func (m *MarginAccount) CollateralMarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.CollateralMarginLevel)
+}
+
+// TotalAssetOfBTCDecimal parse TotalAssetOfBTC as a decimal, see common.ParseDecimal
+func (m *MarginAccount) TotalAssetOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalAssetOfBTC)
+}
+
+// TotalLiabilityOfBTCDecimal parse TotalLiabilityOfBTC as a decimal, see common.ParseDecimal
+func (m *MarginAccount) TotalLiabilityOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalLiabilityOfBTC)
+}
+
+// TotalNetAssetOfBTCDecimal parse TotalNetAssetOfBTC as a decimal, see common.ParseDecimal
+func (m *MarginAccount) TotalNetAssetOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalNetAssetOfBTC)
+}
+
+// TotalCollateralValueInUSDTDecimal parse TotalCollateralValueInUSDT as a decimal, see common.ParseDecimal
+func (m *MarginAccount) TotalCollateralValueInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalCollateralValueInUSDT)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (u *UserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (u *UserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (u *UserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (u *UserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Locked)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (m *MarginPriceIndex) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Price)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (m *MaxBorrowable) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Amount)
+}
+
+// BorrowLimitDecimal parse BorrowLimit as a decimal, see common.ParseDecimal
+func (m *MaxBorrowable) BorrowLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.BorrowLimit)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (m *MaxTransferable) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Amount)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal parse OrigQuoteOrderQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuoteOrderQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CummulativeQuoteQuantity)
+}
+
+// MarginBuyBorrowAmountDecimal parse MarginBuyBorrowAmount as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.MarginBuyBorrowAmount)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (f *Fill) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (f *Fill) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Quantity)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (f *Fill) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Commission)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// IcebergQuantityDecimal parse IcebergQuantity as a decimal, see common.ParseDecimal
+func (o *OCOOrderReport) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.IcebergQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (o *Order) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// IcebergQuantityDecimal parse IcebergQuantity as a decimal, see common.ParseDecimal
+func (o *Order) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.IcebergQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal parse OrigQuoteOrderQuantity as a decimal, see common.ParseDecimal
+func (o *Order) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal parse OrigQuoteOrderQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuoteOrderQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal parse CummulativeQuoteQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CummulativeQuoteQuantity)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (p *PayTradeItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Amount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (f *FundsDetail) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.Amount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *SpotRebateHistoryDataItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// AvgAnnualInterestRateDecimal parse AvgAnnualInterestRate as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) AvgAnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.AvgAnnualInterestRate)
+}
+
+// DailyInterestPerThousandDecimal parse DailyInterestPerThousand as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) DailyInterestPerThousandDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.DailyInterestPerThousand)
+}
+
+// MinPurchaseAmountDecimal parse MinPurchaseAmount as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) MinPurchaseAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MinPurchaseAmount)
+}
+
+// PurchasedAmountDecimal parse PurchasedAmount as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) PurchasedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PurchasedAmount)
+}
+
+// UpLimitDecimal parse UpLimit as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) UpLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UpLimit)
+}
+
+// UpLimitPerUserDecimal parse UpLimitPerUser as a decimal, see common.ParseDecimal
+func (s *SavingsFlexibleProduct) UpLimitPerUserDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UpLimitPerUser)
+}
+
+// InterestPerLotDecimal parse InterestPerLot as a decimal, see common.ParseDecimal
+func (s *SavingsFixedProduct) InterestPerLotDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.InterestPerLot)
+}
+
+// InterestRateDecimal parse InterestRate as a decimal, see common.ParseDecimal
+func (s *SavingsFixedProduct) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.InterestRate)
+}
+
+// LotSizeDecimal parse LotSize as a decimal, see common.ParseDecimal
+func (s *SavingsFixedProduct) LotSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LotSize)
+}
+
+// AvgAnnualInterestRateDecimal parse AvgAnnualInterestRate as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) AvgAnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.AvgAnnualInterestRate)
+}
+
+// AnnualInterestRateDecimal parse AnnualInterestRate as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) AnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.AnnualInterestRate)
+}
+
+// DailyInterestRateDecimal parse DailyInterestRate as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) DailyInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.DailyInterestRate)
+}
+
+// TotalInterestDecimal parse TotalInterest as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) TotalInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalInterest)
+}
+
+// TotalAmountDecimal parse TotalAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAmount)
+}
+
+// TotalPurchasedAmountDecimal parse TotalPurchasedAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) TotalPurchasedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalPurchasedAmount)
+}
+
+// RedeemingAmountDecimal parse RedeemingAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) RedeemingAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RedeemingAmount)
+}
+
+// FreeAmountDecimal parse FreeAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) FreeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.FreeAmount)
+}
+
+// FreezeAmountDecimal parse FreezeAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) FreezeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.FreezeAmount)
+}
+
+// LockedAmountDecimal parse LockedAmount as a decimal, see common.ParseDecimal
+func (s *SavingFlexibleProductPosition) LockedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LockedAmount)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (s *SavingFixedProjectPosition) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Interest)
+}
+
+// InterestRateDecimal parse InterestRate as a decimal, see common.ParseDecimal
+func (s *SavingFixedProjectPosition) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.InterestRate)
+}
+
+// PrincipalDecimal parse Principal as a decimal, see common.ParseDecimal
+func (s *SavingFixedProjectPosition) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Principal)
+}
+
+// TotalAmountInBTCDecimal parse TotalAmountInBTC as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalAmountInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAmountInBTC)
+}
+
+// TotalAmountInUSDTDecimal parse TotalAmountInUSDT as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalAmountInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAmountInUSDT)
+}
+
+// TotalFlexibleAmountInBTCDecimal parse TotalFlexibleAmountInBTC as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalFlexibleAmountInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalFlexibleAmountInBTC)
+}
+
+// TotalFlexibleAmountInUSDTDecimal parse TotalFlexibleAmountInUSDT as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalFlexibleAmountInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalFlexibleAmountInUSDT)
+}
+
+// TotalLockedInBTCDecimal parse TotalLockedInBTC as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalLockedInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLockedInBTC)
+}
+
+// TotalLockedInUSDTDecimal parse TotalLockedInUSDT as a decimal, see common.ParseDecimal
+func (s *SimpleEarnAccount) TotalLockedInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLockedInUSDT)
+}
+
+// LatestAnnualPercentageRateDecimal parse LatestAnnualPercentageRate as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexibleProduct) LatestAnnualPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LatestAnnualPercentageRate)
+}
+
+// AirDropPercentageRateDecimal parse AirDropPercentageRate as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexibleProduct) AirDropPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.AirDropPercentageRate)
+}
+
+// MinPurchaseAmountDecimal parse MinPurchaseAmount as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexibleProduct) MinPurchaseAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MinPurchaseAmount)
+}
+
+// TotalAmountDecimal parse TotalAmount as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexiblePosition) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAmount)
+}
+
+// LatestAnnualPercentageRateDecimal parse LatestAnnualPercentageRate as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexiblePosition) LatestAnnualPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LatestAnnualPercentageRate)
+}
+
+// YesterdayAirdropPercentageRateDecimal parse YesterdayAirdropPercentageRate as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexiblePosition) YesterdayAirdropPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.YesterdayAirdropPercentageRate)
+}
+
+// CollateralAmountDecimal parse CollateralAmount as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexiblePosition) CollateralAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.CollateralAmount)
+}
+
+// CumulativeTotalRewardsDecimal parse CumulativeTotalRewards as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexiblePosition) CumulativeTotalRewardsDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.CumulativeTotalRewards)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// RewardAmtDecimal parse RewardAmt as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedPosition) RewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RewardAmt)
+}
+
+// EstExtraRewardAmtDecimal parse EstExtraRewardAmt as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedPosition) EstExtraRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EstExtraRewardAmt)
+}
+
+// RedeemAmountEarlyDecimal parse RedeemAmountEarly as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedPosition) RedeemAmountEarlyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RedeemAmountEarly)
+}
+
+// RedeemingAmtDecimal parse RedeemingAmt as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedPosition) RedeemingAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RedeemingAmt)
+}
+
+// TotalAmountDecimal parse TotalAmount as a decimal, see common.ParseDecimal
+func (s *SimpleEarnFlexibleSubscriptionPreviewResp) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAmount)
+}
+
+// TotalRewardAmtDecimal parse TotalRewardAmt as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedSubscriptionPreviewResp) TotalRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalRewardAmt)
+}
+
+// EstTotalExtraRewardAmtDecimal parse EstTotalExtraRewardAmt as a decimal, see common.ParseDecimal
+func (s *SimpleEarnLockedSubscriptionPreviewResp) EstTotalExtraRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EstTotalExtraRewardAmt)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// RewardAmountDecimal parse RewardAmount as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) RewardAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RewardAmount)
+}
+
+// EstimatedExtraRewardAmountDecimal parse EstimatedExtraRewardAmount as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) EstimatedExtraRewardAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EstimatedExtraRewardAmount)
+}
+
+// NextInterestPayDecimal parse NextInterestPay as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) NextInterestPayDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.NextInterestPay)
+}
+
+// RedeemAmountEarlyDecimal parse RedeemAmountEarly as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) RedeemAmountEarlyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RedeemAmountEarly)
+}
+
+// RedeemingAmountDecimal parse RedeemingAmount as a decimal, see common.ParseDecimal
+func (s *StakingProductPosition) RedeemingAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RedeemingAmount)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *StakingHistoryTransaction) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// TotalBalanceDecimal parse TotalBalance as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountAsset) TotalBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalBalance)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.AvailableBalance)
+}
+
+// BtcValueDecimal parse BtcValue as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountAsset) BtcValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.BtcValue)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxWithdrawAmount)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal parse TotalMaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalWalletBalance)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.InitialMargin)
+}
+
+// MaintenanceMarginDecimal parse MaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaintenanceMargin)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.WalletBalance)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal parse TotalMaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesSummaryCommon) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalWalletBalance)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (s *SubAccountTransferHistory) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Qty)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (s *SubAccountSpotTransfer) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Qty)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesTransfer) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Qty)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *SubAccountDepositRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountInfo) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarginLevel)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountInfo) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAssetOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountInfo) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountInfo) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalNetAssetOfBtc)
+}
+
+// MarginCallBarDecimal parse MarginCallBar as a decimal, see common.ParseDecimal
+func (m *MarginTradeCoeffVo) MarginCallBarDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarginCallBar)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (m *MarginUserAssetVo) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (m *MarginUserAssetVo) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (m *MarginUserAssetVo) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (m *MarginUserAssetVo) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Locked)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountSummary) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAssetOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountSummary) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SubAccountMarginAccountSummary) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalNetAssetOfBtc)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (m *MarginSubAccount) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalAssetOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (m *MarginSubAccount) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (m *MarginSubAccount) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalNetAssetOfBtc)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxWithdrawAmount)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal parse TotalMaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountV2) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalWalletBalance)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.InitialMargin)
+}
+
+// MaintenanceMarginDecimal parse MaintenanceMargin as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MaintenanceMargin)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (f *FuturesAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.WalletBalance)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal parse TotalMaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesAccountSummary) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalWalletBalance)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal parse TotalMaintenanceMargin as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (f *FuturesSubAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.TotalWalletBalance)
+}
+
+// TotalMarginBalanceOfBTCDecimal parse TotalMarginBalanceOfBTC as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryAccountSummary) TotalMarginBalanceOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalMarginBalanceOfBTC)
+}
+
+// TotalUnrealizedProfitOfBTCDecimal parse TotalUnrealizedProfitOfBTC as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryAccountSummary) TotalUnrealizedProfitOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalUnrealizedProfitOfBTC)
+}
+
+// TotalWalletBalanceOfBTCDecimal parse TotalWalletBalanceOfBTC as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryAccountSummary) TotalWalletBalanceOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalWalletBalanceOfBTC)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (d *DeliverySubAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TotalMarginBalance)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (d *DeliverySubAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (d *DeliverySubAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(d.TotalWalletBalance)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EntryPrice)
+}
+
+// MaxNotionalDecimal parse MaxNotional as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxNotional)
+}
+
+// LiquidationPriceDecimal parse LiquidationPrice as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LiquidationPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarkPrice)
+}
+
+// PositionAmountDecimal parse PositionAmount as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) PositionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PositionAmount)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountFuturesPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UnrealizedProfit)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarkPrice)
+}
+
+// IsolatedWalletDecimal parse IsolatedWallet as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) IsolatedWalletDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.IsolatedWallet)
+}
+
+// IsolatedMarginDecimal parse IsolatedMargin as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.IsolatedMargin)
+}
+
+// PositionAmountDecimal parse PositionAmount as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) PositionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PositionAmount)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (s *SubAccountDeliveryPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.UnrealizedProfit)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (s *SubAccountUniversalTransferRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Amount)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotVoData) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalAssetOfBtc)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (s *SnapshotVoData) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MarginLevel)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotVoData) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (s *SnapshotVoData) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TotalNetAssetOfBtc)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (s *SnapShotSpotBalance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (s *SnapShotSpotBalance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Locked)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (m *MarginUserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (m *MarginUserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (m *MarginUserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (m *MarginUserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Locked)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (f *FuturesUserAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MarginBalance)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (f *FuturesUserAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.WalletBalance)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (f *FuturesUserPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (f *FuturesUserPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MarkPrice)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (f *FuturesUserPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.PositionAmt)
+}
+
+// UnRealizedProfitDecimal parse UnRealizedProfit as a decimal, see common.ParseDecimal
+func (f *FuturesUserPosition) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.UnRealizedProfit)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (m *ManagedSubTransferHistoryVo) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Amount)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (m *ManagedSubFuturesAccountSnapVoDataAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarginBalance)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (m *ManagedSubFuturesAccountSnapVoDataAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.WalletBalance)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (m *ManagedSubFuturesAccountSnapVoDataPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (m *ManagedSubFuturesAccountSnapVoDataPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarkPrice)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (m *ManagedSubFuturesAccountSnapVoDataPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.PositionAmt)
+}
+
+// MarginLevelDecimal parse MarginLevel as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountQueryMarginAssetServiceResponse) MarginLevelDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarginLevel)
+}
+
+// TotalAssetOfBtcDecimal parse TotalAssetOfBtc as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountQueryMarginAssetServiceResponse) TotalAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalAssetOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal parse TotalLiabilityOfBtc as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountQueryMarginAssetServiceResponse) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalLiabilityOfBtc)
+}
+
+// TotalNetAssetOfBtcDecimal parse TotalNetAssetOfBtc as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountQueryMarginAssetServiceResponse) TotalNetAssetOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.TotalNetAssetOfBtc)
+}
+
+// BorrowedDecimal parse Borrowed as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountMarginAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Borrowed)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountMarginAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Free)
+}
+
+// InterestDecimal parse Interest as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountMarginAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Interest)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (m *ManagedSubAccountMarginAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Locked)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (s *SubAccountAssetBalance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (s *SubAccountAssetBalance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Locked)
+}
+
+// Recent30BtcTotalDecimal parse Recent30BtcTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BtcTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BtcTotal)
+}
+
+// Recent30BtcFuturesTotalDecimal parse Recent30BtcFuturesTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BtcFuturesTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BtcFuturesTotal)
+}
+
+// Recent30BtcMarginTotalDecimal parse Recent30BtcMarginTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BtcMarginTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BtcMarginTotal)
+}
+
+// Recent30BusdTotalDecimal parse Recent30BusdTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BusdTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BusdTotal)
+}
+
+// Recent30BusdFuturesTotalDecimal parse Recent30BusdFuturesTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BusdFuturesTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BusdFuturesTotal)
+}
+
+// Recent30BusdMarginTotalDecimal parse Recent30BusdMarginTotal as a decimal, see common.ParseDecimal
+func (s *SubAccountTransactionStatisticServiceResponse) Recent30BusdMarginTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Recent30BusdMarginTotal)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal parse BidQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal parse AskQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (s *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Price)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.WeightedAvgPrice)
+}
+
+// PrevClosePriceDecimal parse PrevClosePrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PrevClosePrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastPrice)
+}
+
+// LastQtyDecimal parse LastQty as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastQty)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BidPrice)
+}
+
+// BidQtyDecimal parse BidQty as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BidQty)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.AskPrice)
+}
+
+// AskQtyDecimal parse AskQty as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.AskQty)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LowPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.QuoteVolume)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (a *AvgPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Price)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.WeightedAvgPrice)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LowPrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LastPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (s *SymbolTicker) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.QuoteVolume)
+}
+
+// MakerCommissionDecimal parse MakerCommission as a decimal, see common.ParseDecimal
+func (t *TradeFeeDetails) MakerCommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.MakerCommission)
+}
+
+// TakerCommissionDecimal parse TakerCommission as a decimal, see common.ParseDecimal
+func (t *TradeFeeDetails) TakerCommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.TakerCommission)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (t *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (t *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal parse QuoteQuantity as a decimal, see common.ParseDecimal
+func (t *Trade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (t *TradeV3) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (t *TradeV3) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal parse QuoteQuantity as a decimal, see common.ParseDecimal
+func (t *TradeV3) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (t *TradeV3) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Commission)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (a *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (a *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Quantity)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.WeightedAvgPrice)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.LowPrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.LastPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (t *TradingDayTicker) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (u *UiKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (u *UiKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (u *UiKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (u *UiKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (u *UiKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (u *UiKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.QuoteVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal parse TakerBuyBaseAssetVolume as a decimal, see common.ParseDecimal
+func (u *UiKline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal parse TakerBuyQuoteAssetVolume as a decimal, see common.ParseDecimal
+func (u *UiKline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.TakerBuyQuoteAssetVolume)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (u *UserUniversalTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Amount)
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (w *WalletBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Balance)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal parse ActiveBuyVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal parse ActiveBuyQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// FreeDecimal parse Free as a decimal, see common.ParseDecimal
+func (w *WsAccountUpdate) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Free)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (w *WsAccountUpdate) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Locked)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.StopPrice)
+}
+
+// IceBergVolumeDecimal parse IceBergVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) IceBergVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.IceBergVolume)
+}
+
+// LatestVolumeDecimal parse LatestVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) LatestVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LatestVolume)
+}
+
+// FilledVolumeDecimal parse FilledVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) FilledVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.FilledVolume)
+}
+
+// LatestPriceDecimal parse LatestPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) LatestPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LatestPrice)
+}
+
+// FeeCostDecimal parse FeeCost as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) FeeCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.FeeCost)
+}
+
+// FilledQuoteVolumeDecimal parse FilledQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) FilledQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.FilledQuoteVolume)
+}
+
+// LatestQuoteVolumeDecimal parse LatestQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) LatestQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LatestQuoteVolume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// PreventedQuantityDecimal parse PreventedQuantity as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) PreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PreventedQuantity)
+}
+
+// LastPreventedQuantityDecimal parse LastPreventedQuantity as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) LastPreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastPreventedQuantity)
+}
+
+// PreventedExecutionQuantityDecimal parse PreventedExecutionQuantity as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) PreventedExecutionQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PreventedExecutionQuantity)
+}
+
+// PreventedExecutionPriceDecimal parse PreventedExecutionPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) PreventedExecutionPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PreventedExecutionPrice)
+}
+
+// PreventedExecutionQuoteQtyDecimal parse PreventedExecutionQuoteQty as a decimal, see common.ParseDecimal
+func (w *WsOrderUpdate) PreventedExecutionQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PreventedExecutionQuoteQty)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.WeightedAvgPrice)
+}
+
+// PrevClosePriceDecimal parse PrevClosePrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PrevClosePrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastPrice)
+}
+
+// CloseQtyDecimal parse CloseQty as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CloseQty)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidPrice)
+}
+
+// BidQtyDecimal parse BidQty as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidQty)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AskPrice)
+}
+
+// AskQtyDecimal parse AskQty as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AskQty)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// BaseVolumeDecimal parse BaseVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BaseVolume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketStatEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastPrice)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// BaseVolumeDecimal parse BaseVolume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BaseVolume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketsStatEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// BestBidPriceDecimal parse BestBidPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidPrice)
+}
+
+// BestBidQtyDecimal parse BestBidQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidQty)
+}
+
+// BestAskPriceDecimal parse BestAskPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskPrice)
+}
+
+// BestAskQtyDecimal parse BestAskQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskQty)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (w *Withdraw) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Amount)
+}
+
+// TransactionFeeDecimal parse TransactionFee as a decimal, see common.ParseDecimal
+func (w *Withdraw) TransactionFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.TransactionFee)
+}
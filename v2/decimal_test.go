@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type decimalTestSuite struct {
+	baseTestSuite
+}
+
+func TestDecimal(t *testing.T) {
+	suite.Run(t, new(decimalTestSuite))
+}
+
+func (s *decimalTestSuite) TestCreateOrderDecimal() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"price": "0.10000000",
+		"origQty": "1.00000000",
+		"executedQty": "0.30000000",
+		"cummulativeQuoteQty": "",
+		"fills": [{"price": "0.10000000", "qty": "0.30000000", "commission": "0.00030000"}]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "LTCBTC",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "1",
+			"price":            "0.1",
+			"newClientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).NewClientOrderID("myOrder1").
+		QuantityDecimal(decimal.RequireFromString("1.000")).
+		PriceDecimal(decimal.RequireFromString("0.10")).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	price, err := res.PriceDecimal()
+	r.NoError(err)
+	r.Equal("0.1", price.String())
+	origQuantity, err := res.OrigQuantityDecimal()
+	r.NoError(err)
+	executedQuantity, err := res.ExecutedQuantityDecimal()
+	r.NoError(err)
+	r.Equal("0.7", origQuantity.Sub(executedQuantity).String())
+	cummulativeQuoteQuantity, err := res.CummulativeQuoteQuantityDecimal()
+	r.NoError(err)
+	r.True(cummulativeQuoteQuantity.IsZero())
+	fillPrice, err := res.Fills[0].PriceDecimal()
+	r.NoError(err)
+	fillQuantity, err := res.Fills[0].QuantityDecimal()
+	r.NoError(err)
+	r.Equal("0.03", fillPrice.Mul(fillQuantity).String())
+}
+
+func (s *decimalTestSuite) TestDecimalGetterError() {
+	order := &Order{Price: "0.1x"}
+	_, err := order.PriceDecimal()
+	s.r().Error(err, "a malformed price is not zero")
+	event := &WsAggTradeEvent{Price: "30000.5", Quantity: ""}
+	price, err := event.PriceDecimal()
+	s.r().NoError(err)
+	s.r().Equal("30000.5", price.String())
+	quantity, err := event.QuantityDecimal()
+	s.r().NoError(err)
+	s.r().True(quantity.IsZero())
+}
+
+func (s *decimalTestSuite) TestMarginOrderDecimal() {
+	data := []byte(`{"symbol": "BTCUSDT", "orderId": 1}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"quantity":         "0.5",
+			"price":            "30000.1",
+			"newClientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewCreateMarginOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		NewClientOrderID("myOrder1").QuantityDecimal(decimal.RequireFromString("0.50")).PriceDecimal(decimal.RequireFromString("30000.10")).
+		Do(newContext())
+	s.r().NoError(err)
+}
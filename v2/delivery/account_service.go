@@ -52,6 +52,7 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/account",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -0,0 +1,927 @@
+package delivery
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice decimal.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
+// CallbackRateDecimal set callbackRate from a decimal
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate decimal.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *UpdatePositionMarginService) AmountDecimal(amount decimal.Decimal) *UpdatePositionMarginService {
+	return s.Amount(amount.String())
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (b *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.Balance)
+}
+
+// WithdrawAvailableDecimal parse WithdrawAvailable as a decimal, see common.ParseDecimal
+func (b *Balance) WithdrawAvailableDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.WithdrawAvailable)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (b *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal parse CrossUnPnl as a decimal, see common.ParseDecimal
+func (b *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (b *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AvailableBalance)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.WalletBalance)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (a *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.UnrealizedProfit)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MarginBalance)
+}
+
+// MaintMarginDecimal parse MaintMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaintMargin)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.InitialMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionInitialMargin)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.OpenOrderInitialMargin)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaxWithdrawAmount)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal parse CrossUnPnl as a decimal, see common.ParseDecimal
+func (a *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AvailableBalance)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (a *AccountPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionAmt)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal parse MaintMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaintMargin)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (a *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.UnrealizedProfit)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionInitialMargin)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.OpenOrderInitialMargin)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (a *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.EntryPrice)
+}
+
+// MaxQtyDecimal parse MaxQty as a decimal, see common.ParseDecimal
+func (a *AccountPosition) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaxQty)
+}
+
+// MaintMarginPercentDecimal parse MaintMarginPercent as a decimal, see common.ParseDecimal
+func (s *Symbol) MaintMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaintMarginPercent)
+}
+
+// RequiredMarginPercentDecimal parse RequiredMarginPercent as a decimal, see common.ParseDecimal
+func (s *Symbol) RequiredMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RequiredMarginPercent)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.StepSize)
+}
+
+// MaxPriceDecimal parse MaxPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxPrice)
+}
+
+// MinPriceDecimal parse MinPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MinPrice)
+}
+
+// TickSizeDecimal parse TickSize as a decimal, see common.ParseDecimal
+func (p *PriceFilter) TickSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.TickSize)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.StepSize)
+}
+
+// AdjustedFundingRateCapDecimal parse AdjustedFundingRateCap as a decimal, see common.ParseDecimal
+func (f *FundingInfo) AdjustedFundingRateCapDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AdjustedFundingRateCap)
+}
+
+// AdjustedFundingRateFloorDecimal parse AdjustedFundingRateFloor as a decimal, see common.ParseDecimal
+func (f *FundingInfo) AdjustedFundingRateFloorDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AdjustedFundingRateFloor)
+}
+
+// FundingRateDecimal parse FundingRate as a decimal, see common.ParseDecimal
+func (f *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.FundingRate)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (f *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MarkPrice)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal parse QuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal parse TakerBuyBaseAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal parse TakerBuyQuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// CumQuantityDecimal parse CumQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQuantity)
+}
+
+// CumBaseDecimal parse CumBase as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) CumBaseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumBase)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.AvgPrice)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.PriceRate)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (o *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.AvgPrice)
+}
+
+// CumBaseDecimal parse CumBase as a decimal, see common.ParseDecimal
+func (o *Order) CumBaseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.CumBase)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (o *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (o *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.PriceRate)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.AvgPrice)
+}
+
+// CumQuantityDecimal parse CumQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQuantity)
+}
+
+// CumBaseDecimal parse CumBase as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) CumBaseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumBase)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.PriceRate)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.ExecutedQuantity)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.AveragePrice)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (p *PositionRisk) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PositionAmt)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkPrice)
+}
+
+// UnRealizedProfitDecimal parse UnRealizedProfit as a decimal, see common.ParseDecimal
+func (p *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.UnRealizedProfit)
+}
+
+// LiquidationPriceDecimal parse LiquidationPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LiquidationPrice)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (p *PositionRisk) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxQuantity)
+}
+
+// IsolatedMarginDecimal parse IsolatedMargin as a decimal, see common.ParseDecimal
+func (p *PositionRisk) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IsolatedMargin)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (s *SymbolLeverage) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxQuantity)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal parse BidQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal parse AskQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (s *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Price)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.WeightedAvgPrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastPrice)
+}
+
+// LastQuantityDecimal parse LastQuantity as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastQuantity)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LowPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Volume)
+}
+
+// BaseVolumeDecimal parse BaseVolume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BaseVolume)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (w *WsIndexPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.IndexPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// EstimatedSettlePriceDecimal parse EstimatedSettlePrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal parse FundingRate as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.FundingRate)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal parse ActiveBuyVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal parse ActiveBuyQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal parse ActiveBuyVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal parse ActiveBuyQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsIndexPriceKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsIndexPriceKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsIndexPriceKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsIndexPriceKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// ClosePriceDecimal parse ClosePrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ClosePrice)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.WeightedAvgPrice)
+}
+
+// ClosePriceDecimal parse ClosePrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ClosePrice)
+}
+
+// CloseQtyDecimal parse CloseQty as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CloseQty)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// BaseVolumeDecimal parse BaseVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BaseVolume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// BestBidPriceDecimal parse BestBidPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidPrice)
+}
+
+// BestBidQtyDecimal parse BestBidQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidQty)
+}
+
+// BestAskPriceDecimal parse BestAskPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskPrice)
+}
+
+// BestAskQtyDecimal parse BestAskQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskQty)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AvgPrice)
+}
+
+// LastFilledQtyDecimal parse LastFilledQty as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal parse AccumulatedFilledQty as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AccumulatedFilledQty)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (e *WsUserDataEvent) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.CrossWalletBalance)
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (w *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Balance)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (w *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CrossWalletBalance)
+}
+
+// BalanceChangeDecimal parse BalanceChange as a decimal, see common.ParseDecimal
+func (w *WsBalance) BalanceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BalanceChange)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (w *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Amount)
+}
+
+// IsolatedWalletDecimal parse IsolatedWallet as a decimal, see common.ParseDecimal
+func (w *WsPosition) IsolatedWalletDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.IsolatedWallet)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (w *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// UnrealizedPnLDecimal parse UnrealizedPnL as a decimal, see common.ParseDecimal
+func (w *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.UnrealizedPnL)
+}
+
+// MaintenanceMarginRequiredDecimal parse MaintenanceMarginRequired as a decimal, see common.ParseDecimal
+func (w *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MaintenanceMarginRequired)
+}
+
+// OriginalQtyDecimal parse OriginalQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalQty)
+}
+
+// OriginalPriceDecimal parse OriginalPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalPrice)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AveragePrice)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.StopPrice)
+}
+
+// LastFilledQtyDecimal parse LastFilledQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal parse AccumulatedFilledQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AccumulatedFilledQty)
+}
+
+// LastFilledPriceDecimal parse LastFilledPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledPrice)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Commission)
+}
+
+// RealizedPnLDecimal parse RealizedPnL as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.RealizedPnL)
+}
+
+// BidsNotionalDecimal parse BidsNotional as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidsNotional)
+}
+
+// AsksNotionalDecimal parse AsksNotional as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AsksNotional)
+}
+
+// ActivationPriceDecimal parse ActivationPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActivationPrice)
+}
+
+// CallbackRateDecimal parse CallbackRate as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CallbackRate)
+}
@@ -43,12 +43,7 @@ type ExchangeInfo struct {
 }
 
 // RateLimit struct
-type RateLimit struct {
-	RateLimitType string `json:"rateLimitType"`
-	Interval      string `json:"interval"`
-	IntervalNum   int64  `json:"intervalNum"`
-	Limit         int64  `json:"limit"`
-}
+type RateLimit = common.RateLimit
 
 // Symbol market symbol
 type Symbol struct {
@@ -28,6 +28,7 @@ type CreateOrderService struct {
 	workingType      *WorkingType
 	priceProtect     *string
 	newOrderRespType NewOrderRespType
+	validator        *OrderValidator
 }
 
 // Symbol set symbol
@@ -130,6 +131,9 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 }
 
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if err = s.validate(ctx); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -251,10 +255,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/openOrders",
+		weight:   40,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
+		r.weight = 1
 	}
 	if s.pair != "" {
 		r.setParam("pair", s.symbol)
@@ -403,6 +409,7 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/allOrders",
+		weight:   20,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
@@ -410,6 +417,7 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	}
 	if s.pair != "" {
 		r.setParam("pair", s.pair)
+		r.weight = 40
 	}
 	if s.orderID != nil {
 		r.setParam("orderId", *s.orderID)
@@ -587,6 +595,7 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/allForceOrders",
+		weight:   50,
 		secType:  secTypeNone,
 	}
 	if s.pair != nil {
@@ -594,6 +603,7 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 20
 	}
 	if s.startTime != nil {
 		r.setParam("startTime", *s.startTime)
@@ -0,0 +1,141 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidator check and round the orders against the filters of a cached exchange info before
+// they are sent, the rejected orders return a *common.OrderFilterError instead of a -1013 error
+type OrderValidator struct {
+	// Rounding round the prices and quantities to the tick and step sizes,
+	// the values which are not on a step are rejected by default
+	Rounding common.RoundingMode
+	// OpenOrders return the number of open orders of a symbol, MAX_NUM_ORDERS is checked when it is set
+	OpenOrders func(ctx context.Context, symbol string) (int, error)
+	// ReferencePrice return the mark price of a symbol, e.g. from the markPrice stream.
+	// PERCENT_PRICE is checked when it is set.
+	ReferencePrice func(ctx context.Context, symbol string) (decimal.Decimal, error)
+
+	mu      sync.RWMutex
+	symbols map[string]*Symbol
+}
+
+// NewOrderValidator init an OrderValidator with the symbols of info
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{}
+	v.SetExchangeInfo(info)
+	return v
+}
+
+// SetExchangeInfo replace the symbols the orders are checked against
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*Symbol, len(info.Symbols))
+	for i := range info.Symbols {
+		symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.mu.Unlock()
+}
+
+// Symbol return the cached symbol, nil when it is unknown
+func (v *OrderValidator) Symbol(symbol string) *Symbol {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.symbols[symbol]
+}
+
+// orderField is a parameter of an order, it is rounded in place
+type orderField struct {
+	name  string
+	value *string
+}
+
+// isMarketOrder report whether an order type is executed at the market price
+func isMarketOrder(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// Validate check and round the order with v before it is sent
+func (s *CreateOrderService) Validate(v *OrderValidator) *CreateOrderService {
+	s.validator = v
+	return s
+}
+
+// validate round the fields of the order and check them against the filters of its symbol
+func (s *CreateOrderService) validate(ctx context.Context) error {
+	v := s.validator
+	if v == nil {
+		return nil
+	}
+	symbol := v.Symbol(s.symbol)
+	if symbol == nil {
+		return fmt.Errorf("symbol %s not found in the exchange info", s.symbol)
+	}
+	check := common.OrderCheck{Symbol: s.symbol, Rounding: v.Rounding}
+	market := isMarketOrder(s.orderType)
+	if f := symbol.PriceFilter(); f != nil {
+		prices := []orderField{{"stopPrice", s.stopPrice}, {"activationPrice", s.activationPrice}}
+		if !market {
+			prices = append(prices, orderField{"price", s.price})
+		}
+		for _, p := range prices {
+			if err := check.Step(string(SymbolFilterTypePrice), p.name, p.value, f.MinPrice, f.MaxPrice, f.TickSize); err != nil {
+				return err
+			}
+		}
+	}
+	if f := symbol.LotSizeFilter(); f != nil && !market {
+		if err := check.Step(string(SymbolFilterTypeLotSize), "quantity", &s.quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil && market {
+		if err := check.Step(string(SymbolFilterTypeMarketLotSize), "quantity", &s.quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	}
+	var price *decimal.Decimal
+	if !market {
+		var err error
+		if price, err = check.Decimal("price", s.price); err != nil {
+			return err
+		}
+	}
+	var reference *decimal.Decimal
+	if v.ReferencePrice != nil && price != nil && symbol.PercentPriceFilter() != nil {
+		ref, err := v.ReferencePrice(ctx, s.symbol)
+		if err != nil {
+			return err
+		}
+		reference = &ref
+	}
+	if f := symbol.PercentPriceFilter(); f != nil && price != nil && reference != nil {
+		if up := common.ToDecimal(f.MultiplierUp); s.side == SideTypeBuy && up.Sign() > 0 && price.GreaterThan(reference.Mul(up)) {
+			return check.Errorf(string(SymbolFilterTypePercentPrice), "price", "price %s is above %s, %s times the mark price", price, reference.Mul(up), up)
+		}
+		if down := common.ToDecimal(f.MultiplierDown); s.side == SideTypeSell && price.LessThan(reference.Mul(down)) {
+			return check.Errorf(string(SymbolFilterTypePercentPrice), "price", "price %s is below %s, %s times the mark price", price, reference.Mul(down), down)
+		}
+	}
+	if f := symbol.MaxNumOrdersFilter(); f != nil && f.Limit > 0 && v.OpenOrders != nil {
+		n, err := v.OpenOrders(ctx, s.symbol)
+		if err != nil {
+			return err
+		}
+		if int64(n) >= f.Limit {
+			return check.Errorf(string(SymbolFilterTypeMaxNumOrders), "", "%d open orders, at most %d are allowed", n, f.Limit)
+		}
+	}
+	return nil
+}
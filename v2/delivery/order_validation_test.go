@@ -0,0 +1,63 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func (s *orderValidationTestSuite) TestValidateOrder() {
+	info := new(ExchangeInfo)
+	r := s.r()
+	r.NoError(json.Unmarshal([]byte(`{"symbols": [{
+		"symbol": "BTCUSD_PERP",
+		"filters": [
+			{"filterType": "PRICE_FILTER", "minPrice": "1000", "maxPrice": "4520958", "tickSize": "0.1"},
+			{"filterType": "LOT_SIZE", "minQty": "1", "maxQty": "1000000", "stepSize": "1"},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "1", "maxQty": "60000", "stepSize": "1"},
+			{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+			{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+		]
+	}]}`), info))
+	v := NewOrderValidator(info)
+	s.mockDo([]byte(`{}`), nil)
+	defer s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+	newOrder := func() *CreateOrderService {
+		return s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Validate(v)
+	}
+	assertFilter := func(err error, filter string) {
+		var filterErr *common.OrderFilterError
+		r.ErrorAs(err, &filterErr)
+		r.Equal(filter, filterErr.Filter, err.Error())
+	}
+
+	_, err := newOrder().Quantity("1.5").Price("30000").Do(newContext())
+	assertFilter(err, "LOT_SIZE")
+	v.Rounding = common.RoundingUp
+	order := newOrder().Quantity("1.5").Price("30000.01")
+	r.NoError(order.validate(newContext()))
+	r.Equal("2", order.quantity)
+	r.Equal("30000.1", *order.price)
+
+	v.ReferencePrice = func(ctx context.Context, symbol string) (decimal.Decimal, error) {
+		return decimal.NewFromInt(30000), nil
+	}
+	_, err = newOrder().Side(SideTypeSell).Quantity("1").Price("28000").Do(newContext())
+	assertFilter(err, "PERCENT_PRICE")
+	_, err = newOrder().Type(OrderTypeMarket).Quantity("60001").Do(newContext())
+	assertFilter(err, "MARKET_LOT_SIZE")
+}
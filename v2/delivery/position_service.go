@@ -197,6 +197,7 @@ func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/positionSide/dual",
+		weight:   30,
 		secType:  secTypeSigned,
 	}
 	r.setFormParams(params{})
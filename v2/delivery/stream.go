@@ -0,0 +1,26 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Subscribe open a stream with serve on a copy of e, DefaultEnvironment() when nil, and deliver its messages,
+// errors and reconnections on a common.Stream. The stream is stopped when ctx is done, e.g.
+//
+//	stream, err := delivery.Subscribe(ctx, env, func(e *delivery.Environment, handler func(*delivery.WsAggTradeEvent), errHandler delivery.ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return e.WsAggTradeServe("BTCUSD_PERP", handler, errHandler)
+//	})
+//
+// Cancelling ctx closes the connection, Next then returns the error of ctx.
+func Subscribe[T any](ctx context.Context, e *Environment, serve func(e *Environment, handler func(event T), errHandler ErrHandler) (doneC, stopC chan struct{}, err error)) (*common.Stream[T], error) {
+	if e == nil {
+		e = DefaultEnvironment()
+	}
+	return common.NewStream(ctx, e.WsReconnect, func(handler func(event T), errHandler func(err error), reconnect *common.WsReconnect) (doneC, stopC chan struct{}, err error) {
+		env := *e
+		env.WsReconnect = reconnect
+		return serve(&env, handler, errHandler)
+	})
+}
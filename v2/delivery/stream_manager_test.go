@@ -0,0 +1,73 @@
+package delivery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamManager(t *testing.T) {
+	messages := map[string]string{
+		"@depth5":    `{"e":"depthUpdate","E":1,"T":2,"s":"BTCUSD_PERP","U":157,"u":160,"pu":149,"b":[["7403.89","0.002"]],"a":[["7405.96","3.340"]]}`,
+		"@aggTrade":  `{"e":"aggTrade","s":"BTCUSD_PERP","a":5933014,"p":"0.001"}`,
+		"@ticker":    `{"e":"24hrTicker","s":"BTCUSD_PERP","c":"0.0025"}`,
+		"@kline_15m": `{"e":"kline","s":"BTCUSD_PERP","k":{"i":"15m","c":"0.0020"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			var req struct {
+				Params []string `json:"params"`
+				ID     int64    `json:"id"`
+			}
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			c.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID})
+			for _, stream := range req.Params {
+				data := messages[stream[strings.Index(stream, "@"):]]
+				c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"`+stream+`","data":`+data+`}`))
+			}
+		}
+	}))
+	defer server.Close()
+	env := MainnetEnvironment()
+	env.CombinedWsURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/stream?streams="
+	m := env.NewStreamManager(nil)
+	defer m.Close()
+
+	events := make(chan interface{}, 1)
+	next := func() interface{} {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event")
+			return nil
+		}
+	}
+	assert.Error(t, m.SubscribePartialDepth(func(event *WsDepthEvent) {}, 3, "BTCUSD_PERP"))
+	require.NoError(t, m.SubscribePartialDepth(func(event *WsDepthEvent) { events <- event }, 5, "BTCUSD_PERP"))
+	depth := next().(*WsDepthEvent)
+	assert.Equal(t, int64(149), depth.PrevLastUpdateID)
+	assert.Equal(t, []Bid{{Price: "7403.89", Quantity: "0.002"}}, depth.Bids)
+	require.NoError(t, m.SubscribeAggTrade(func(event *WsAggTradeEvent) { events <- event }, "BTCUSD_PERP"))
+	assert.Equal(t, int64(5933014), next().(*WsAggTradeEvent).AggregateTradeID)
+	require.NoError(t, m.SubscribeTicker(func(event *WsMarketTickerEvent) { events <- event }, "BTCUSD_PERP"))
+	assert.Equal(t, "0.0025", next().(*WsMarketTickerEvent).ClosePrice)
+	require.NoError(t, m.SubscribeKline(func(event *WsKlineEvent) { events <- event }, "15m", "BTCUSD_PERP"))
+	assert.Equal(t, "0.0020", next().(*WsKlineEvent).Kline.Close)
+
+	require.NoError(t, m.Unsubscribe("btcusd_perp@ticker"))
+	assert.Equal(t, []string{"btcusd_perp@aggTrade", "btcusd_perp@depth5", "btcusd_perp@kline_15m"}, m.Streams())
+}
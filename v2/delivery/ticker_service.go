@@ -30,9 +30,11 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/ticker/bookTicker",
+		weight:   5,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 2
 	}
 	if s.pair != nil {
 		r.setParam("pair", *s.pair)
@@ -84,9 +86,11 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/ticker/price",
+		weight:   2,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 	if s.pair != nil {
 		r.setParam("pair", *s.pair)
@@ -135,9 +139,11 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/dapi/v1/ticker/24hr",
+		weight:   40,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 	if s.pair != nil {
 		r.setParam("pair", *s.pair)
@@ -1,11 +1,11 @@
 package delivery
 
 import (
-	"net/http"
-	"net/url"
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // WsHandler handle raw websocket message
@@ -18,23 +18,50 @@ type ErrHandler func(err error)
 type WsConfig struct {
 	Endpoint string
 	Proxy    *string
+	// Keepalive enables sending ping/pong messages every Timeout
+	Keepalive bool
+	Timeout   time.Duration
+	// Middlewares wrap the opening of the stream, see Environment.WsMiddlewares
+	Middlewares []common.WsMiddleware
+	// Reconnect reconnects the stream when its connection is lost, see Environment.WsReconnect
+	Reconnect *common.WsReconnect
+	// Dispatcher queues the messages for the handler, see Environment.WsDispatcher
+	Dispatcher *common.WsDispatcher
+}
+
+// defaultWsTimeout is the keepalive interval of the streams configured without a positive Timeout
+const defaultWsTimeout = 60 * time.Second
+
+var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	serve := common.ChainWsMiddleware(func(endpoint string, handler func(message []byte), errHandler func(err error)) (chan struct{}, chan struct{}, error) {
+		return wsDial(cfg, endpoint, handler, errHandler)
+	}, wsMiddlewares(cfg)...)
+	return serve(cfg.Endpoint, handler, errHandler)
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
-		Endpoint: endpoint,
-		Proxy:    getWsProxyUrl(),
+// wsMiddlewares return the middlewares of the stream. The dispatcher is the outermost one so that
+// the other middlewares see the messages when they are read, the reconnection is the innermost one
+// so that the other middlewares see a single stream.
+func wsMiddlewares(cfg *WsConfig) []common.WsMiddleware {
+	if cfg.Reconnect == nil && cfg.Dispatcher == nil {
+		return cfg.Middlewares
+	}
+	middlewares := make([]common.WsMiddleware, 0, len(cfg.Middlewares)+2)
+	if cfg.Dispatcher != nil {
+		middlewares = append(middlewares, cfg.Dispatcher.Middleware())
 	}
+	middlewares = append(middlewares, cfg.Middlewares...)
+	if cfg.Reconnect != nil {
+		middlewares = append(middlewares, cfg.Reconnect.Middleware())
+	}
+	return middlewares
 }
 
-var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	proxy := http.ProxyFromEnvironment
-	if cfg.Proxy != nil {
-		u, err := url.Parse(*cfg.Proxy)
-		if err != nil {
-			return nil, nil, err
-		}
-		proxy = http.ProxyURL(u)
+// wsDial connect to endpoint and read its messages until stopC is closed
+func wsDial(cfg *WsConfig, endpoint string, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	proxy, err := common.WsProxy(cfg.Proxy)
+	if err != nil {
+		return nil, nil, err
 	}
 	Dialer := websocket.Dialer{
 		Proxy:             proxy,
@@ -42,7 +69,7 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		EnableCompression: true,
 	}
 
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+	c, _, err := Dialer.Dial(endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,8 +81,8 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		// websocket.Conn.ReadMessage or when the stopC channel is
 		// closed by the client.
 		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
+		if cfg.Keepalive {
+			keepAlive(c, cfg.Timeout)
 		}
 		// Wait for the stopC channel to be closed.  We do that in a
 		// separate goroutine because ReadMessage is a blocking
@@ -84,6 +111,9 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 }
 
 func keepAlive(c *websocket.Conn, timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultWsTimeout
+	}
 	ticker := time.NewTicker(timeout)
 
 	lastResponse := time.Now()
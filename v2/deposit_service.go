@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListDepositsService fetches deposit history.
@@ -104,8 +107,31 @@ func (s *ListDepositsService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// Iterator iterate over the deposits between StartTime and EndTime, the last 90 days by default.
+// The deposits are fetched by offset in windows of 90 days.
+func (s *ListDepositsService) Iterator(opts ...RequestOption) *common.Iterator[*Deposit] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.OffsetPager[*Deposit]{
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    90 * 24 * time.Hour,
+		Limit:     limit,
+		Now:       s.c.serverTimestamp,
+		Fetch: func(ctx context.Context, start, end int64, offset int) ([]*Deposit, error) {
+			p := *s
+			p.startTime, p.endTime, p.offset, p.limit = &start, &end, &offset, &limit
+			return p.Do(ctx, opts...)
+		},
+		Key: func(d *Deposit) string { return d.ID },
+	}.Pages())
+}
+
 // Deposit represents a single deposit entry.
 type Deposit struct {
+	ID            string `json:"id"`
 	Amount        string `json:"amount"`
 	Coin          string `json:"coin"`
 	Network       string `json:"network"`
@@ -31,6 +31,7 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/depth",
+		weight:   depthWeight(s.limit),
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
@@ -67,6 +68,19 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	return res, nil
 }
 
+// depthWeight return the weight of the order book at limit, 100 by default
+func depthWeight(limit *int) int64 {
+	switch {
+	case limit == nil || *limit <= 100:
+		return 5
+	case *limit <= 500:
+		return 25
+	case *limit <= 1000:
+		return 50
+	}
+	return 250
+}
+
 // DepthResponse define depth info with bids and asks
 type DepthResponse struct {
 	LastUpdateID int64 `json:"lastUpdateId"`
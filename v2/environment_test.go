@@ -0,0 +1,199 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestDefaultEnvironment(t *testing.T) {
+	assert.Equal(t, MainnetEnvironment().APIURL, DefaultEnvironment().APIURL)
+
+	UseTestnet = true
+	defer func() { UseTestnet = false }()
+	e := DefaultEnvironment()
+	assert.Equal(t, BaseAPITestnetURL, e.APIURL)
+	assert.Equal(t, BaseWsTestnetURL, e.WsURL)
+	assert.Equal(t, BaseWsApiTestnetURL, e.WsAPIURL)
+}
+
+func TestClientEnvironment(t *testing.T) {
+	testnet := TestnetEnvironment()
+	testnet.ProxyURL = "http://127.0.0.1:7890"
+	testnet.HTTPTimeout = 5 * time.Second
+	mainnet := NewClient("dummyAPIKey", "dummySecretKey")
+	c := NewClient("dummyAPIKey", "dummySecretKey", testnet)
+
+	assert.Equal(t, BaseAPIMainURL, mainnet.BaseURL)
+	assert.Equal(t, http.DefaultClient, mainnet.HTTPClient)
+	assert.Equal(t, BaseAPITestnetURL, c.BaseURL)
+	assert.Equal(t, testnet, c.Environment)
+	assert.Equal(t, 5*time.Second, c.HTTPClient.Timeout)
+	proxy, err := c.HTTPClient.Transport.(*http.Transport).Proxy(&http.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:7890", proxy.Host)
+}
+
+func TestEnvironmentWsServe(t *testing.T) {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var configs []*WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		configs = append(configs, cfg)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	testnet := TestnetEnvironment()
+	testnet.WsKeepalive = true
+	testnet.ProxyURL = "http://127.0.0.1:7890"
+	_, _, err := testnet.WsDepthServe("BNBBTC", func(event *WsDepthEvent) {}, func(err error) {})
+	assert.NoError(t, err)
+	_, _, err = WsDepthServe("BNBBTC", func(event *WsDepthEvent) {}, func(err error) {})
+	assert.NoError(t, err)
+	_, _, err = NewClient("", "", testnet).Environment.WsCombinedTradeServe([]string{"BNBBTC"}, func(event *WsCombinedTradeEvent) {}, func(err error) {})
+	assert.NoError(t, err)
+
+	assert.Len(t, configs, 3)
+	assert.Equal(t, "wss://testnet.binance.vision/ws/bnbbtc@depth", configs[0].Endpoint)
+	assert.True(t, configs[0].Keepalive)
+	assert.Equal(t, 60*time.Second, configs[0].Timeout)
+	assert.Equal(t, "http://127.0.0.1:7890", *configs[0].Proxy)
+	assert.Equal(t, "wss://stream.binance.com:9443/ws/bnbbtc@depth", configs[1].Endpoint)
+	assert.False(t, configs[1].Keepalive)
+	assert.Nil(t, configs[1].Proxy)
+	assert.Equal(t, "wss://testnet.binance.vision/stream?streams=bnbbtc@trade", configs[2].Endpoint)
+}
+
+func TestEnvironmentWsReconnect(t *testing.T) {
+	// the server sends a trade on every connection, then drops it
+	var connections int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		id := atomic.AddInt64(&connections, 1)
+		c.WriteMessage(websocket.TextMessage, []byte(`{"e":"trade","s":"BNBBTC","t":`+strconv.FormatInt(id, 10)+`}`))
+		if id == 1 {
+			return
+		}
+		c.ReadMessage()
+	}))
+	defer server.Close()
+
+	env := MainnetEnvironment()
+	env.WsURL = "ws" + strings.TrimPrefix(server.URL, "http")
+	gaps := make(chan string, 1)
+	env.WsReconnect = &common.WsReconnect{
+		MinDelay: time.Millisecond,
+		OnGap: func(endpoint string, start, end time.Time) {
+			gaps <- endpoint
+		},
+	}
+	trades := make(chan int64, 2)
+	doneC, stopC, err := env.WsTradeServe("BNBBTC", func(event *WsTradeEvent) {
+		trades <- event.TradeID
+	}, func(err error) {})
+	require.NoError(t, err)
+	for _, id := range []int64{1, 2} {
+		select {
+		case trade := <-trades:
+			assert.Equal(t, id, trade)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no trade")
+		}
+	}
+	assert.Equal(t, env.WsURL+"/bnbbtc@trade", <-gaps)
+	close(stopC)
+	<-doneC
+}
+
+func TestEnvironmentWsDispatcher(t *testing.T) {
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for id := 1; id <= 3; id++ {
+			if id == 2 {
+				<-started
+			}
+			c.WriteMessage(websocket.TextMessage, []byte(`{"u":`+strconv.Itoa(id)+`,"s":"BNBBTC","b":"0.1","B":"1","a":"0.2","A":"1"}`))
+		}
+		c.ReadMessage()
+	}))
+	defer server.Close()
+
+	env := MainnetEnvironment()
+	env.WsURL = "ws" + strings.TrimPrefix(server.URL, "http")
+	env.WsDispatcher = &common.WsDispatcher{Overflow: common.OverflowConflate}
+	release := make(chan struct{})
+	updates := make(chan int64, 3)
+	doneC, stopC, err := env.WsBookTickerServe("BNBBTC", func(event *WsBookTickerEvent) {
+		if event.UpdateID == 1 {
+			close(started)
+		}
+		<-release
+		updates <- event.UpdateID
+	}, func(err error) {})
+	require.NoError(t, err)
+	// the second update waiting for the slow handler is replaced by the third one
+	assert.Eventually(t, func() bool {
+		stats := env.WsDispatcher.Stats()
+		return len(stats) == 1 && stats[0].Conflated == 1
+	}, 5*time.Second, time.Millisecond)
+	close(release)
+	assert.Equal(t, int64(1), <-updates)
+	assert.Equal(t, int64(3), <-updates)
+	close(stopC)
+	<-doneC
+}
+
+func TestWsServeKeepaliveWithoutTimeout(t *testing.T) {
+	pings := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.SetPingHandler(func(data string) error {
+			select {
+			case pings <- struct{}{}:
+			default:
+			}
+			return c.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+		})
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	// a zero Timeout uses the default keepalive interval instead of panicking
+	cfg := &WsConfig{Endpoint: "ws" + strings.TrimPrefix(server.URL, "http"), Keepalive: true}
+	doneC, stopC, err := wsServe(cfg, func(message []byte) {}, func(err error) {})
+	require.NoError(t, err)
+	select {
+	case <-pings:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no ping")
+	}
+	close(stopC)
+	<-doneC
+}
@@ -49,6 +49,7 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/exchangeInfo",
+		weight:   20,
 		secType:  secTypeNone,
 	}
 	m := params{}
@@ -88,12 +89,7 @@ type ExchangeInfo struct {
 }
 
 // RateLimit struct
-type RateLimit struct {
-	RateLimitType string `json:"rateLimitType"`
-	Interval      string `json:"interval"`
-	IntervalNum   int64  `json:"intervalNum"`
-	Limit         int64  `json:"limit"`
-}
+type RateLimit = common.RateLimit
 
 // Symbol market symbol
 type Symbol struct {
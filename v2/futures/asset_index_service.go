@@ -34,9 +34,11 @@ func (s *AssetIndexService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/assetIndex",
+		weight:   10,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -22,6 +22,7 @@ func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/commissionRate",
+		weight:   20,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
@@ -31,6 +31,7 @@ func (s *ConstituentsService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/constituents",
+		weight:   2,
 	}
 	r.setParam("symbol", s.symbol)
 
@@ -58,6 +58,7 @@ func (s *ContinuousKlinesService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/continuousKlines",
+		weight:   klinesWeight(s.limit),
 	}
 	r.setParam("pair", s.pair)
 	r.setParam("contractType", s.contractType)
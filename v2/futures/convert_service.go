@@ -35,6 +35,7 @@ func (l *ListConvertExchangeInfoService) Do(ctx context.Context, opts ...Request
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/convert/exchangeInfo",
+		weight:   20,
 		secType:  secTypeNone,
 	}
 	if l.fromAsset != "" {
@@ -111,6 +112,7 @@ func (c *CreateConvertQuoteService) Do(ctx context.Context, opts ...RequestOptio
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/convert/getQuote",
+		weight:   50,
 		secType:  secTypeSigned,
 	}
 	m := params{
@@ -167,6 +169,7 @@ func (c *ConvertAcceptService) Do(ctx context.Context, opts ...RequestOption) (r
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/convert/acceptQuote",
+		weight:   200,
 		secType:  secTypeSigned,
 	}
 	m := params{
@@ -216,6 +219,7 @@ func (c *ConvertStatusService) Do(ctx context.Context, opts ...RequestOption) (r
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/convert/orderStatus",
+		weight:   50,
 		secType:  secTypeSigned,
 	}
 	m := params{
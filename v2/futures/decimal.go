@@ -0,0 +1,1527 @@
+package futures
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// FromAmountDecimal set fromAmount from a decimal
+func (c *CreateConvertQuoteService) FromAmountDecimal(fromAmount decimal.Decimal) *CreateConvertQuoteService {
+	return c.FromAmount(fromAmount.String())
+}
+
+// ToAmountDecimal set toAmount from a decimal
+func (c *CreateConvertQuoteService) ToAmountDecimal(toAmount decimal.Decimal) *CreateConvertQuoteService {
+	return c.ToAmount(toAmount.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *OrderPlaceWsRequest) QuantityDecimal(quantity decimal.Decimal) *OrderPlaceWsRequest {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *OrderPlaceWsRequest) PriceDecimal(price decimal.Decimal) *OrderPlaceWsRequest {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *OrderPlaceWsRequest) StopPriceDecimal(stopPrice decimal.Decimal) *OrderPlaceWsRequest {
+	return s.StopPrice(stopPrice.String())
+}
+
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *OrderPlaceWsRequest) ActivationPriceDecimal(activationPrice decimal.Decimal) *OrderPlaceWsRequest {
+	return s.ActivationPrice(activationPrice.String())
+}
+
+// CallbackRateDecimal set callbackRate from a decimal
+func (s *OrderPlaceWsRequest) CallbackRateDecimal(callbackRate decimal.Decimal) *OrderPlaceWsRequest {
+	return s.CallbackRate(callbackRate.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice decimal.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
+// CallbackRateDecimal set callbackRate from a decimal
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate decimal.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *ModifyOrderService) QuantityDecimal(quantity decimal.Decimal) *ModifyOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *ModifyOrderService) PriceDecimal(price decimal.Decimal) *ModifyOrderService {
+	return s.Price(price.String())
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *ModifyOrder) QuantityDecimal(quantity decimal.Decimal) *ModifyOrder {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *ModifyOrder) PriceDecimal(price decimal.Decimal) *ModifyOrder {
+	return s.Price(price.String())
+}
+
+// AmountDecimal set amount from a decimal
+func (s *UpdatePositionMarginService) AmountDecimal(amount decimal.Decimal) *UpdatePositionMarginService {
+	return s.Amount(amount.String())
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (b *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.Balance)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (b *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal parse CrossUnPnl as a decimal, see common.ParseDecimal
+func (b *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (b *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (b *Balance) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.MaxWithdrawAmount)
+}
+
+// TotalInitialMarginDecimal parse TotalInitialMargin as a decimal, see common.ParseDecimal
+func (a *Account) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalInitialMargin)
+}
+
+// TotalMaintMarginDecimal parse TotalMaintMargin as a decimal, see common.ParseDecimal
+func (a *Account) TotalMaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalMaintMargin)
+}
+
+// TotalWalletBalanceDecimal parse TotalWalletBalance as a decimal, see common.ParseDecimal
+func (a *Account) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalWalletBalance)
+}
+
+// TotalUnrealizedProfitDecimal parse TotalUnrealizedProfit as a decimal, see common.ParseDecimal
+func (a *Account) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalUnrealizedProfit)
+}
+
+// TotalMarginBalanceDecimal parse TotalMarginBalance as a decimal, see common.ParseDecimal
+func (a *Account) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalMarginBalance)
+}
+
+// TotalPositionInitialMarginDecimal parse TotalPositionInitialMargin as a decimal, see common.ParseDecimal
+func (a *Account) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalPositionInitialMargin)
+}
+
+// TotalOpenOrderInitialMarginDecimal parse TotalOpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (a *Account) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalOpenOrderInitialMargin)
+}
+
+// TotalCrossWalletBalanceDecimal parse TotalCrossWalletBalance as a decimal, see common.ParseDecimal
+func (a *Account) TotalCrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalCrossWalletBalance)
+}
+
+// TotalCrossUnPnlDecimal parse TotalCrossUnPnl as a decimal, see common.ParseDecimal
+func (a *Account) TotalCrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.TotalCrossUnPnl)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (a *Account) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (a *Account) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaxWithdrawAmount)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal parse MaintMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaintMargin)
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal parse MaxWithdrawAmount as a decimal, see common.ParseDecimal
+func (a *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (a *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal parse WalletBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.WalletBalance)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal parse CrossUnPnl as a decimal, see common.ParseDecimal
+func (a *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal parse AvailableBalance as a decimal, see common.ParseDecimal
+func (a *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AvailableBalance)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal parse MaintMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaintMargin)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (a *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal parse UnrealizedProfit as a decimal, see common.ParseDecimal
+func (a *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.UnrealizedProfit)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (a *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.EntryPrice)
+}
+
+// MaxNotionalDecimal parse MaxNotional as a decimal, see common.ParseDecimal
+func (a *AccountPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MaxNotional)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (a *AccountPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.PositionAmt)
+}
+
+// NotionalDecimal parse Notional as a decimal, see common.ParseDecimal
+func (a *AccountPosition) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Notional)
+}
+
+// BidNotionalDecimal parse BidNotional as a decimal, see common.ParseDecimal
+func (a *AccountPosition) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.BidNotional)
+}
+
+// AskNotionalDecimal parse AskNotional as a decimal, see common.ParseDecimal
+func (a *AccountPosition) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AskNotional)
+}
+
+// BidBufferDecimal parse BidBuffer as a decimal, see common.ParseDecimal
+func (a *AssetIndex) BidBufferDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.BidBuffer)
+}
+
+// AskBufferDecimal parse AskBuffer as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AskBufferDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AskBuffer)
+}
+
+// BidRateDecimal parse BidRate as a decimal, see common.ParseDecimal
+func (a *AssetIndex) BidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.BidRate)
+}
+
+// AskRateDecimal parse AskRate as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AskRate)
+}
+
+// AutoExchangeBidBufferDecimal parse AutoExchangeBidBuffer as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AutoExchangeBidBufferDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AutoExchangeBidBuffer)
+}
+
+// AutoExchangeAskBufferDecimal parse AutoExchangeAskBuffer as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AutoExchangeAskBufferDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AutoExchangeAskBuffer)
+}
+
+// AutoExchangeBidRateDecimal parse AutoExchangeBidRate as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AutoExchangeBidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AutoExchangeBidRate)
+}
+
+// AutoExchangeAskRateDecimal parse AutoExchangeAskRate as a decimal, see common.ParseDecimal
+func (a *AssetIndex) AutoExchangeAskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.AutoExchangeAskRate)
+}
+
+// MakerCommissionRateDecimal parse MakerCommissionRate as a decimal, see common.ParseDecimal
+func (c *CommissionRate) MakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.MakerCommissionRate)
+}
+
+// TakerCommissionRateDecimal parse TakerCommissionRate as a decimal, see common.ParseDecimal
+func (c *CommissionRate) TakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.TakerCommissionRate)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Volume)
+}
+
+// QuoteAssetVolumeDecimal parse QuoteAssetVolume as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal parse TakerBuyBaseAssetVolume as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal parse TakerBuyQuoteAssetVolume as a decimal, see common.ParseDecimal
+func (c *ContinuousKline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.TakerBuyQuoteAssetVolume)
+}
+
+// FromAssetMinAmountDecimal parse FromAssetMinAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) FromAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAssetMinAmount)
+}
+
+// FromAssetMaxAmountDecimal parse FromAssetMaxAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) FromAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAssetMaxAmount)
+}
+
+// ToAssetMinAmountDecimal parse ToAssetMinAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) ToAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAssetMinAmount)
+}
+
+// ToAssetMaxAmountDecimal parse ToAssetMaxAmount as a decimal, see common.ParseDecimal
+func (c *ConvertExchangeInfo) ToAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAssetMaxAmount)
+}
+
+// ToAmountDecimal parse ToAmount as a decimal, see common.ParseDecimal
+func (c *ConvertQuote) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAmount)
+}
+
+// FromAmountDecimal parse FromAmount as a decimal, see common.ParseDecimal
+func (c *ConvertQuote) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAmount)
+}
+
+// FromAmountDecimal parse FromAmount as a decimal, see common.ParseDecimal
+func (c *ConvertStatusResult) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.FromAmount)
+}
+
+// ToAmountDecimal parse ToAmount as a decimal, see common.ParseDecimal
+func (c *ConvertStatusResult) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ToAmount)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (b *Basis) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.IndexPrice)
+}
+
+// BasisRateDecimal parse BasisRate as a decimal, see common.ParseDecimal
+func (b *Basis) BasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BasisRate)
+}
+
+// FuturesPriceDecimal parse FuturesPrice as a decimal, see common.ParseDecimal
+func (b *Basis) FuturesPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.FuturesPrice)
+}
+
+// AnnualizedBasisRateDecimal parse AnnualizedBasisRate as a decimal, see common.ParseDecimal
+func (b *Basis) AnnualizedBasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AnnualizedBasisRate)
+}
+
+// MaintMarginPercentDecimal parse MaintMarginPercent as a decimal, see common.ParseDecimal
+func (s *Symbol) MaintMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaintMarginPercent)
+}
+
+// RequiredMarginPercentDecimal parse RequiredMarginPercent as a decimal, see common.ParseDecimal
+func (s *Symbol) RequiredMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.RequiredMarginPercent)
+}
+
+// LiquidationFeeDecimal parse LiquidationFee as a decimal, see common.ParseDecimal
+func (s *Symbol) LiquidationFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.LiquidationFee)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.StepSize)
+}
+
+// MaxPriceDecimal parse MaxPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxPrice)
+}
+
+// MinPriceDecimal parse MinPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MinPrice)
+}
+
+// TickSizeDecimal parse TickSize as a decimal, see common.ParseDecimal
+func (p *PriceFilter) TickSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.TickSize)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (m *MarketLotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.StepSize)
+}
+
+// NotionalDecimal parse Notional as a decimal, see common.ParseDecimal
+func (m *MinNotionalFilter) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Notional)
+}
+
+// AdjustedFundingRateCapDecimal parse AdjustedFundingRateCap as a decimal, see common.ParseDecimal
+func (f *FundingRateInfo) AdjustedFundingRateCapDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AdjustedFundingRateCap)
+}
+
+// AdjustedFundingRateFloorDecimal parse AdjustedFundingRateFloor as a decimal, see common.ParseDecimal
+func (f *FundingRateInfo) AdjustedFundingRateFloorDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.AdjustedFundingRateFloor)
+}
+
+// IncomeDecimal parse Income as a decimal, see common.ParseDecimal
+func (i *IncomeHistory) IncomeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.Income)
+}
+
+// WeightInQuantityDecimal parse WeightInQuantity as a decimal, see common.ParseDecimal
+func (b *BaseAssetList) WeightInQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.WeightInQuantity)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal parse QuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal parse TakerBuyBaseAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal parse TakerBuyQuoteAssetVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (l *LvtKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (l *LvtKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (l *LvtKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (l *LvtKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Close)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (p *PremiumIndex) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkPrice)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (p *PremiumIndex) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal parse EstimatedSettlePrice as a decimal, see common.ParseDecimal
+func (p *PremiumIndex) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.EstimatedSettlePrice)
+}
+
+// LastFundingRateDecimal parse LastFundingRate as a decimal, see common.ParseDecimal
+func (p *PremiumIndex) LastFundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastFundingRate)
+}
+
+// InterestRateDecimal parse InterestRate as a decimal, see common.ParseDecimal
+func (p *PremiumIndex) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.InterestRate)
+}
+
+// FundingRateDecimal parse FundingRate as a decimal, see common.ParseDecimal
+func (f *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.FundingRate)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (f *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(f.MarkPrice)
+}
+
+// OpenInterestDecimal parse OpenInterest as a decimal, see common.ParseDecimal
+func (o *OpenInterest) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OpenInterest)
+}
+
+// SumOpenInterestDecimal parse SumOpenInterest as a decimal, see common.ParseDecimal
+func (o *OpenInterestStatistic) SumOpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.SumOpenInterest)
+}
+
+// SumOpenInterestValueDecimal parse SumOpenInterestValue as a decimal, see common.ParseDecimal
+func (o *OpenInterestStatistic) SumOpenInterestValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.SumOpenInterestValue)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// CumQuoteDecimal parse CumQuote as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQuote)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.PriceRate)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.AvgPrice)
+}
+
+// CumQtyDecimal parse CumQty as a decimal, see common.ParseDecimal
+func (c *CreateOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.Price)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.AveragePrice)
+}
+
+// OriginalQuantityDecimal parse OriginalQuantity as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) OriginalQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.OriginalQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.ExecutedQuantity)
+}
+
+// CumulativeQuantityDecimal parse CumulativeQuantity as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) CumulativeQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.CumulativeQuantity)
+}
+
+// CumulativeBaseDecimal parse CumulativeBase as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) CumulativeBaseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.CumulativeBase)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (m *ModifyOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.StopPrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// CumQuantityDecimal parse CumQuantity as a decimal, see common.ParseDecimal
+func (o *Order) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.CumQuantity)
+}
+
+// CumQuoteDecimal parse CumQuote as a decimal, see common.ParseDecimal
+func (o *Order) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.CumQuote)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (o *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (o *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.PriceRate)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (o *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.AvgPrice)
+}
+
+// CumQuantityDecimal parse CumQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQuantity)
+}
+
+// CumQuoteDecimal parse CumQuote as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.CumQuote)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.Price)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal parse ActivatePrice as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal parse PriceRate as a decimal, see common.ParseDecimal
+func (c *CancelOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(c.PriceRate)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Price)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.ExecutedQuantity)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (l *LiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.AveragePrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Price)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.AveragePrice)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal parse ExecutedQuantity as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.ExecutedQuantity)
+}
+
+// CumQuoteDecimal parse CumQuote as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.CumQuote)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (u *UserLiquidationOrder) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.StopPrice)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (p *PositionMarginHistory) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Amount)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.EntryPrice)
+}
+
+// BreakEvenPriceDecimal parse BreakEvenPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BreakEvenPrice)
+}
+
+// IsolatedMarginDecimal parse IsolatedMargin as a decimal, see common.ParseDecimal
+func (p *PositionRisk) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IsolatedMargin)
+}
+
+// LiquidationPriceDecimal parse LiquidationPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LiquidationPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (p *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkPrice)
+}
+
+// MaxNotionalValueDecimal parse MaxNotionalValue as a decimal, see common.ParseDecimal
+func (p *PositionRisk) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxNotionalValue)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (p *PositionRisk) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PositionAmt)
+}
+
+// UnRealizedProfitDecimal parse UnRealizedProfit as a decimal, see common.ParseDecimal
+func (p *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.UnRealizedProfit)
+}
+
+// NotionalDecimal parse Notional as a decimal, see common.ParseDecimal
+func (p *PositionRisk) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Notional)
+}
+
+// IsolatedWalletDecimal parse IsolatedWallet as a decimal, see common.ParseDecimal
+func (p *PositionRisk) IsolatedWalletDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IsolatedWallet)
+}
+
+// PositionAmtDecimal parse PositionAmt as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PositionAmt)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.EntryPrice)
+}
+
+// BreakEvenPriceDecimal parse BreakEvenPrice as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BreakEvenPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkPrice)
+}
+
+// UnRealizedProfitDecimal parse UnRealizedProfit as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.UnRealizedProfit)
+}
+
+// LiquidationPriceDecimal parse LiquidationPrice as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LiquidationPrice)
+}
+
+// IsolatedMarginDecimal parse IsolatedMargin as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IsolatedMargin)
+}
+
+// NotionalDecimal parse Notional as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Notional)
+}
+
+// IsolatedWalletDecimal parse IsolatedWallet as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) IsolatedWalletDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.IsolatedWallet)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.InitialMargin)
+}
+
+// MaintMarginDecimal parse MaintMargin as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaintMargin)
+}
+
+// PositionInitialMarginDecimal parse PositionInitialMargin as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PositionInitialMargin)
+}
+
+// OpenOrderInitialMarginDecimal parse OpenOrderInitialMargin as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.OpenOrderInitialMargin)
+}
+
+// BidNotionalDecimal parse BidNotional as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.BidNotional)
+}
+
+// AskNotionalDecimal parse AskNotional as a decimal, see common.ParseDecimal
+func (p *PositionRiskV3) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.AskNotional)
+}
+
+// MaxNotionalValueDecimal parse MaxNotionalValue as a decimal, see common.ParseDecimal
+func (s *SymbolLeverage) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxNotionalValue)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal parse BidQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal parse AskQuantity as a decimal, see common.ParseDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.AskQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (s *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.Price)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.WeightedAvgPrice)
+}
+
+// PrevClosePriceDecimal parse PrevClosePrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PrevClosePrice)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastPrice)
+}
+
+// LastQuantityDecimal parse LastQuantity as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LastQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LastQuantity)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.LowPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (p *PriceChangeStats) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.QuoteVolume)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (t *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (t *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal parse QuoteQuantity as a decimal, see common.ParseDecimal
+func (t *Trade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (t *TradeV3) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (t *TradeV3) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal parse QuoteQuantity as a decimal, see common.ParseDecimal
+func (t *TradeV3) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (t *TradeV3) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Commission)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (a *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (a *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Quantity)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (a *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Commission)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (a *AccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (a *AccountTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Quantity)
+}
+
+// QuoteQuantityDecimal parse QuoteQuantity as a decimal, see common.ParseDecimal
+func (a *AccountTrade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.QuoteQuantity)
+}
+
+// RealizedPnlDecimal parse RealizedPnl as a decimal, see common.ParseDecimal
+func (a *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.RealizedPnl)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal parse EstimatedSettlePrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal parse FundingRate as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.FundingRate)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal parse ActiveBuyVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal parse ActiveBuyQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal parse ActiveBuyVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal parse ActiveBuyQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsContinuousKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// ClosePriceDecimal parse ClosePrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ClosePrice)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMiniMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal parse WeightedAvgPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.WeightedAvgPrice)
+}
+
+// ClosePriceDecimal parse ClosePrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ClosePrice)
+}
+
+// CloseQtyDecimal parse CloseQty as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CloseQty)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// BaseVolumeDecimal parse BaseVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BaseVolume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// BestBidPriceDecimal parse BestBidPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidPrice)
+}
+
+// BestBidQtyDecimal parse BestBidQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestBidQty)
+}
+
+// BestAskPriceDecimal parse BestAskPrice as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskPrice)
+}
+
+// BestAskQtyDecimal parse BestAskQty as a decimal, see common.ParseDecimal
+func (w *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BestAskQty)
+}
+
+// OrigQuantityDecimal parse OrigQuantity as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OrigQuantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AvgPrice)
+}
+
+// LastFilledQtyDecimal parse LastFilledQty as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal parse AccumulatedFilledQty as a decimal, see common.ParseDecimal
+func (w *WsLiquidationOrder) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AccumulatedFilledQty)
+}
+
+// OpenPriceDecimal parse OpenPrice as a decimal, see common.ParseDecimal
+func (w *WsBLVTKline) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenPrice)
+}
+
+// ClosePriceDecimal parse ClosePrice as a decimal, see common.ParseDecimal
+func (w *WsBLVTKline) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ClosePrice)
+}
+
+// HighPriceDecimal parse HighPrice as a decimal, see common.ParseDecimal
+func (w *WsBLVTKline) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPrice)
+}
+
+// LowPriceDecimal parse LowPrice as a decimal, see common.ParseDecimal
+func (w *WsBLVTKline) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsCompositeIndexEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// WeightQtyDecimal parse WeightQty as a decimal, see common.ParseDecimal
+func (w *WsComposition) WeightQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.WeightQty)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (w *WsUserDataMarginCall) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CrossWalletBalance)
+}
+
+// OriginalQtyDecimal parse OriginalQty as a decimal, see common.ParseDecimal
+func (w *WsUserDataTradeLite) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalQty)
+}
+
+// OriginalPriceDecimal parse OriginalPrice as a decimal, see common.ParseDecimal
+func (w *WsUserDataTradeLite) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalPrice)
+}
+
+// LastFilledPriceDecimal parse LastFilledPrice as a decimal, see common.ParseDecimal
+func (w *WsUserDataTradeLite) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal parse LastFilledQty as a decimal, see common.ParseDecimal
+func (w *WsUserDataTradeLite) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledQty)
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (w *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Balance)
+}
+
+// CrossWalletBalanceDecimal parse CrossWalletBalance as a decimal, see common.ParseDecimal
+func (w *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CrossWalletBalance)
+}
+
+// ChangeBalanceDecimal parse ChangeBalance as a decimal, see common.ParseDecimal
+func (w *WsBalance) ChangeBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ChangeBalance)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (w *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Amount)
+}
+
+// IsolatedWalletDecimal parse IsolatedWallet as a decimal, see common.ParseDecimal
+func (w *WsPosition) IsolatedWalletDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.IsolatedWallet)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (w *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.EntryPrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// UnrealizedPnLDecimal parse UnrealizedPnL as a decimal, see common.ParseDecimal
+func (w *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.UnrealizedPnL)
+}
+
+// MaintenanceMarginRequiredDecimal parse MaintenanceMarginRequired as a decimal, see common.ParseDecimal
+func (w *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MaintenanceMarginRequired)
+}
+
+// OriginalQtyDecimal parse OriginalQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalQty)
+}
+
+// OriginalPriceDecimal parse OriginalPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OriginalPrice)
+}
+
+// AveragePriceDecimal parse AveragePrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AveragePrice)
+}
+
+// StopPriceDecimal parse StopPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.StopPrice)
+}
+
+// LastFilledQtyDecimal parse LastFilledQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal parse AccumulatedFilledQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AccumulatedFilledQty)
+}
+
+// LastFilledPriceDecimal parse LastFilledPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastFilledPrice)
+}
+
+// CommissionDecimal parse Commission as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Commission)
+}
+
+// BidsNotionalDecimal parse BidsNotional as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidsNotional)
+}
+
+// AsksNotionalDecimal parse AsksNotional as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AsksNotional)
+}
+
+// ActivationPriceDecimal parse ActivationPrice as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ActivationPrice)
+}
+
+// CallbackRateDecimal parse CallbackRate as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CallbackRate)
+}
+
+// RealizedPnLDecimal parse RealizedPnL as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.RealizedPnL)
+}
@@ -3,6 +3,7 @@ package futures
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -73,3 +74,11 @@ func (s *depthServiceTestSuite) assertDepthResponseEqual(e, a *DepthResponse) {
 		r.Equal(e.Asks[i].Quantity, a.Asks[i].Quantity, "Quantity")
 	}
 }
+
+func TestDepthWeight(t *testing.T) {
+	limit := func(l int) *int { return &l }
+	assert.Equal(t, int64(10), depthWeight(nil))
+	assert.Equal(t, int64(2), depthWeight(limit(50)))
+	assert.Equal(t, int64(5), depthWeight(limit(100)))
+	assert.Equal(t, int64(20), depthWeight(limit(1000)))
+}
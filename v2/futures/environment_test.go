@@ -0,0 +1,31 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentSideBySide(t *testing.T) {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	mainnet := NewClient("dummyAPIKey", "dummySecretKey", MainnetEnvironment())
+	testnet := NewClient("dummyAPIKey", "dummySecretKey", TestnetEnvironment())
+	assert.Equal(t, BaseApiMainUrl, mainnet.BaseURL)
+	assert.Equal(t, BaseApiTestnetUrl, testnet.BaseURL)
+
+	_, _, err := mainnet.Environment.WsMarkPriceServe("BTCUSDT", func(event *WsMarkPriceEvent) {}, func(err error) {})
+	assert.NoError(t, err)
+	_, _, err = testnet.Environment.WsMarkPriceServe("BTCUSDT", func(event *WsMarkPriceEvent) {}, func(err error) {})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"wss://fstream.binance.com/ws/btcusdt@markPrice",
+		"wss://stream.binancefuture.com/ws/btcusdt@markPrice",
+	}, endpoints)
+}
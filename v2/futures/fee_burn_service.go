@@ -63,6 +63,7 @@ func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/feeBurn",
+		weight:   30,
 		secType:  secTypeSigned,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetIncomeHistoryService get position margin history service
@@ -51,6 +55,7 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/income",
+		weight:   30,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
@@ -79,6 +84,29 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator iterate over the incomes between StartTime and EndTime, the last 200 days by default.
+// The incomes are fetched by windows of 200 days.
+func (s *GetIncomeHistoryService) Iterator(opts ...RequestOption) *common.Iterator[*IncomeHistory] {
+	limit := int64(maxHistoryLimit)
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.TimePager[*IncomeHistory]{
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    200 * 24 * time.Hour,
+		Now:       s.c.serverTimestamp,
+		Fetch: func(ctx context.Context, start, end int64) ([]*IncomeHistory, bool, error) {
+			p := *s
+			p.startTime, p.endTime, p.limit = &start, &end, &limit
+			incomes, err := p.Do(ctx, opts...)
+			return incomes, int64(len(incomes)) >= limit, err
+		},
+		Time: func(i *IncomeHistory) int64 { return i.Time },
+		Key:  func(i *IncomeHistory) string { return i.IncomeType + "/" + strconv.FormatInt(i.TranID, 10) },
+	}.Pages())
+}
+
 // IncomeHistory define position margin history info
 type IncomeHistory struct {
 	Asset      string `json:"asset"`
@@ -34,9 +34,11 @@ func (s *IndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res [
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/v1/indexInfo",
+		weight:   10,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -51,6 +51,7 @@ func (ipks *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOpti
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/indexPriceKlines",
+		weight:   klinesWeight(ipks.limit),
 	}
 	r.setParam("pair", ipks.pair)
 	r.setParam("interval", ipks.interval)
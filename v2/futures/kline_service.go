@@ -51,6 +51,7 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/klines",
+		weight:   klinesWeight(s.limit),
 	}
 	r.setParam("symbol", s.symbol)
 	r.setParam("interval", s.interval)
@@ -96,6 +97,21 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	return res, nil
 }
 
+// klinesWeight return the weight of the klines at limit, 500 by default
+func klinesWeight(limit *int) int64 {
+	switch {
+	case limit == nil:
+		return 5
+	case *limit < 100:
+		return 1
+	case *limit < 500:
+		return 2
+	case *limit <= 1000:
+		return 5
+	}
+	return 10
+}
+
 // Kline define kline info
 type Kline struct {
 	OpenTime                 int64  `json:"openTime"`
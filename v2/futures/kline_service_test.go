@@ -3,6 +3,7 @@ package futures
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -112,3 +113,12 @@ func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r.Equal(e.TakerBuyBaseAssetVolume, a.TakerBuyBaseAssetVolume, "TakerBuyBaseAssetVolume")
 	r.Equal(e.TakerBuyQuoteAssetVolume, a.TakerBuyQuoteAssetVolume, "TakerBuyQuoteAssetVolume")
 }
+
+func TestKlinesWeight(t *testing.T) {
+	limit := func(l int) *int { return &l }
+	assert.Equal(t, int64(5), klinesWeight(nil))
+	assert.Equal(t, int64(1), klinesWeight(limit(99)))
+	assert.Equal(t, int64(2), klinesWeight(limit(100)))
+	assert.Equal(t, int64(5), klinesWeight(limit(1000)))
+	assert.Equal(t, int64(10), klinesWeight(limit(1500)))
+}
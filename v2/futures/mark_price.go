@@ -25,10 +25,12 @@ func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/premiumIndex",
+		weight:   10,
 		secType:  secTypeNone,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	data = common.ToJSONList(data)
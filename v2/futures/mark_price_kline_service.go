@@ -51,6 +51,7 @@ func (mpks *MarkPriceKlinesService) Do(ctx context.Context, opts ...RequestOptio
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/markPriceKlines",
+		weight:   klinesWeight(mpks.limit),
 	}
 	r.setParam("symbol", mpks.symbol)
 	r.setParam("interval", mpks.interval)
@@ -0,0 +1,42 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewOrderBook init the local order book of symbol, see common.OrderBook. The updates must follow each other
+// through their pu field. Unless they are set in cfg, the snapshot is fetched with a DepthService of limit 1000
+// and the updates are read from the WsDiffDepthServe stream of the environment of the client,
+// DefaultEnvironment() when nil.
+func (c *Client) NewOrderBook(symbol string, cfg common.OrderBookConfig) *common.OrderBook {
+	cfg.Rules = common.FuturesDepthRules
+	if cfg.Snapshot == nil {
+		cfg.Snapshot = func(ctx context.Context) (*common.DepthSnapshot, error) {
+			res, err := c.NewDepthService().Symbol(symbol).Limit(1000).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &common.DepthSnapshot{LastUpdateID: res.LastUpdateID, Bids: res.Bids, Asks: res.Asks}, nil
+		}
+	}
+	if cfg.Stream == nil {
+		env := c.Environment
+		if env == nil {
+			env = DefaultEnvironment()
+		}
+		cfg.Stream = func(handler func(update *common.DepthUpdate), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return env.WsDiffDepthServe(symbol, func(event *WsDepthEvent) {
+				handler(&common.DepthUpdate{
+					FirstUpdateID:    event.FirstUpdateID,
+					LastUpdateID:     event.LastUpdateID,
+					PrevLastUpdateID: event.PrevLastUpdateID,
+					Bids:             event.Bids,
+					Asks:             event.Asks,
+				})
+			}, errHandler)
+		}
+	}
+	return common.NewOrderBook(cfg)
+}
@@ -0,0 +1,84 @@
+package futures
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) TestSync() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	handlers := make(chan WsHandler, 1)
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		handlers <- handler
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	s.mockDo([]byte(`{"lastUpdateId":100,"E":1,"T":1,"bids":[["9638.0","431"]],"asks":[["9638.2","12"]]}`), nil)
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest().setParam("symbol", "BTCUSDT").setParam("limit", 1000), r)
+	})
+
+	states := make(chan common.BookState, 10)
+	book := s.client.NewOrderBook("BTCUSDT", common.OrderBookConfig{
+		OnState: func(state common.BookState, err error) { states <- state },
+	})
+	s.r().NoError(book.Start(newContext()))
+	defer book.Close()
+	ctx, cancel := context.WithTimeout(newContext(), 5*time.Second)
+	defer cancel()
+	s.r().NoError(book.WaitSynced(ctx))
+	s.assertDo()
+	s.r().Equal(s.client.Environment.WsURL+"/btcusdt@depth", endpoint)
+	s.r().Equal(common.BookStateSynced, <-states)
+
+	handler := <-handlers
+	handler([]byte(`{"e":"depthUpdate","s":"BTCUSDT","U":95,"u":102,"pu":94,"b":[["9638.1","5"]],"a":[]}`))
+	handler([]byte(`{"e":"depthUpdate","s":"BTCUSDT","U":103,"u":104,"pu":102,"b":[],"a":[["9638.2","0"]]}`))
+	s.r().Eventually(func() bool { return book.LastUpdateID() == 104 }, 5*time.Second, time.Millisecond)
+	bid, _ := book.BestBid()
+	s.r().Equal("9638.1", bid.Price.String())
+	_, ok := book.BestAsk()
+	s.r().False(ok)
+
+	// a missing update breaks the pu chain
+	handler([]byte(`{"e":"depthUpdate","s":"BTCUSDT","U":106,"u":107,"pu":105,"b":[],"a":[]}`))
+	s.r().Equal(common.BookStateOutOfSync, <-states)
+}
+
+func (s *orderBookTestSuite) TestNilEnvironment() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+
+	c := &Client{}
+	book := c.NewOrderBook("BTCUSDT", common.OrderBookConfig{
+		Snapshot: func(ctx context.Context) (*common.DepthSnapshot, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	s.r().NoError(book.Start(ctx))
+	book.Close()
+	s.r().Equal(DefaultEnvironment().WsURL+"/btcusdt@depth", endpoint)
+}
@@ -2,6 +2,7 @@ package futures
 
 import (
 	"encoding/json"
+	"sync/atomic"
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -76,21 +77,31 @@ type OrderCancelWsResponse struct {
 
 // OrderCancelWsService cancel order
 type OrderCancelWsService struct {
-	c          websocket.Client
-	ApiKey     string
-	SecretKey  string
-	KeyType    string
+	c         websocket.Client
+	ApiKey    string
+	SecretKey string
+	KeyType   string
+	// Signer signs the requests instead of KeyType and SecretKey when set
+	Signer common.Signer
+	// TimeOffset can be kept in sync with a client by TimeSync.Attach(&service.TimeOffset)
 	TimeOffset int64
+	// signers keep the in-process signer of KeyType and SecretKey
+	signers common.SignerCache
 }
 
 // NewOrderCancelWsService init OrderCancelWsService
 func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, error) {
-	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	return DefaultEnvironment().NewOrderCancelWsService(apiKey, secretKey)
+}
+
+// NewOrderCancelWsService init OrderCancelWsService connected to the websocket API of the environment
+func (e *Environment) NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, error) {
+	conn, err := websocket.NewConnection(e.WsApiInitReadWriteConn, e.WsKeepalive, e.WsAPITimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := websocket.NewClient(conn)
+	client, err := websocket.NewClient(conn, websocket.WithLogger(e.Logger))
 	if err != nil {
 		return nil, err
 	}
@@ -103,16 +114,28 @@ func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, e
 	}, nil
 }
 
+// signer return Signer when set, else the signer of KeyType and SecretKey parsed once per service
+func (s *OrderCancelWsService) signer() (common.Signer, error) {
+	if s.Signer != nil || s.SecretKey == "" || s.KeyType == "" {
+		return s.Signer, nil
+	}
+	return s.signers.Signer(s.KeyType, s.SecretKey)
+}
+
 // Do - sends 'order.cancel' request
 func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest) error {
+	signer, err := s.signer()
+	if err != nil {
+		return err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -129,14 +152,18 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest)
 
 // SyncDo - sends 'order.cancel' request and receives response
 func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequest) (*OrderCancelWsResponse, error) {
+	signer, err := s.signer()
+	if err != nil {
+		return nil, err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
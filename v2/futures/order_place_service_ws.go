@@ -2,6 +2,7 @@ package futures
 
 import (
 	"encoding/json"
+	"sync/atomic"
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -10,21 +11,31 @@ import (
 
 // OrderPlaceWsService creates order
 type OrderPlaceWsService struct {
-	c          websocket.Client
-	ApiKey     string
-	SecretKey  string
-	KeyType    string
+	c         websocket.Client
+	ApiKey    string
+	SecretKey string
+	KeyType   string
+	// Signer signs the requests instead of KeyType and SecretKey when set
+	Signer common.Signer
+	// TimeOffset can be kept in sync with a client by TimeSync.Attach(&service.TimeOffset)
 	TimeOffset int64
+	// signers keep the in-process signer of KeyType and SecretKey
+	signers common.SignerCache
 }
 
 // NewOrderPlaceWsService init OrderPlaceWsService
 func NewOrderPlaceWsService(apiKey, secretKey string) (*OrderPlaceWsService, error) {
-	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	return DefaultEnvironment().NewOrderPlaceWsService(apiKey, secretKey)
+}
+
+// NewOrderPlaceWsService init OrderPlaceWsService connected to the websocket API of the environment
+func (e *Environment) NewOrderPlaceWsService(apiKey, secretKey string) (*OrderPlaceWsService, error) {
+	conn, err := websocket.NewConnection(e.WsApiInitReadWriteConn, e.WsKeepalive, e.WsAPITimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := websocket.NewClient(conn)
+	client, err := websocket.NewClient(conn, websocket.WithLogger(e.Logger))
 	if err != nil {
 		return nil, err
 	}
@@ -237,16 +248,28 @@ func (s *OrderPlaceWsRequest) buildParams() params {
 	return m
 }
 
+// signer return Signer when set, else the signer of KeyType and SecretKey parsed once per service
+func (s *OrderPlaceWsService) signer() (common.Signer, error) {
+	if s.Signer != nil || s.SecretKey == "" || s.KeyType == "" {
+		return s.Signer, nil
+	}
+	return s.signers.Signer(s.KeyType, s.SecretKey)
+}
+
 // Do - sends 'order.place' request
 func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest) error {
+	signer, err := s.signer()
+	if err != nil {
+		return err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -263,14 +286,18 @@ func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest)
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequest) (*CreateOrderWsResponse, error) {
+	signer, err := s.signer()
+	if err != nil {
+		return nil, err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -32,6 +32,7 @@ type CreateOrderService struct {
 	newOrderRespType        NewOrderRespType
 	closePosition           *string
 	selfTradePreventionMode *SelfTradePreventionMode
+	validator               *OrderValidator
 }
 
 // Symbol set symbol
@@ -140,6 +141,9 @@ func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode Sel
 }
 
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	if err = s.validate(ctx); err != nil {
+		return nil, nil, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -403,10 +407,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/openOrders",
+		weight:   40,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
+		r.weight = 1
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -599,6 +605,7 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/allOrders",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
@@ -824,10 +831,12 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/allForceOrders",
+		weight:   50,
 		secType:  secTypeNone,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 20
 	}
 	if s.startTime != nil {
 		r.setParam("startTime", *s.startTime)
@@ -909,12 +918,14 @@ func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...Reque
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/forceOrders",
+		weight:   50,
 		secType:  secTypeSigned,
 	}
 
 	r.setParam("autoCloseType", s.autoCloseType)
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 20
 	}
 	if s.startTime != nil {
 		r.setParam("startTime", *s.startTime)
@@ -994,6 +1005,7 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/batchOrders",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -1183,6 +1195,7 @@ func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	r := &request{
 		method:   http.MethodPut,
 		endpoint: "/fapi/v1/batchOrders",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -0,0 +1,103 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+	validator *OrderValidator
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func (s *orderValidationTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	info := new(ExchangeInfo)
+	s.r().NoError(json.Unmarshal([]byte(`{"symbols": [{
+		"symbol": "BTCUSDT",
+		"filters": [
+			{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+			{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+			{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+			{"filterType": "MIN_NOTIONAL", "notional": "100"},
+			{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+		]
+	}]}`), info))
+	s.validator = NewOrderValidator(info)
+}
+
+func (s *orderValidationTestSuite) TestRoundOrder() {
+	data := []byte(`{"symbol": "BTCUSDT", "orderId": 1}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "0.124",
+			"price":            "30000.1",
+			"newClientOrderId": "myOrder1",
+			"newOrderRespType": "",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	s.validator.Rounding = common.RoundingNearest
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).NewClientOrderID("myOrder1").
+		Quantity("0.1236").Price("30000.08").Validate(s.validator).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *orderValidationTestSuite) TestRejectOrder() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+	newOrder := func() *CreateOrderService {
+		return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Validate(s.validator)
+	}
+	r := s.r()
+	assertFilter := func(err error, filter string) {
+		var filterErr *common.OrderFilterError
+		r.ErrorAs(err, &filterErr)
+		r.Equal(filter, filterErr.Filter, err.Error())
+	}
+
+	_, err := newOrder().Quantity("0.1").Price("30000.05").Do(newContext())
+	assertFilter(err, "PRICE_FILTER")
+	_, err = newOrder().Quantity("0.0015").Price("30000").Do(newContext())
+	assertFilter(err, "LOT_SIZE")
+	_, err = newOrder().Quantity("0.001").Price("30000").Do(newContext())
+	assertFilter(err, "MIN_NOTIONAL")
+	// reduce only orders are exempt from the minimum notional
+	r.NoError(newOrder().Quantity("0.001").Price("30000").ReduceOnly(true).validate(newContext()))
+
+	s.validator.ReferencePrice = func(ctx context.Context, symbol string) (decimal.Decimal, error) {
+		return decimal.NewFromInt(30000), nil
+	}
+	_, err = newOrder().Quantity("0.1").Price("32000").Do(newContext())
+	assertFilter(err, "PERCENT_PRICE")
+	r.NoError(newOrder().Side(SideTypeSell).Quantity("0.1").Price("32000").validate(newContext()))
+	_, err = newOrder().Type(OrderTypeMarket).Quantity("0.003").Do(newContext())
+	assertFilter(err, "MIN_NOTIONAL")
+	_, err = newOrder().Type(OrderTypeMarket).Quantity("121").Do(newContext())
+	assertFilter(err, "MARKET_LOT_SIZE")
+
+	s.validator.OpenOrders = func(ctx context.Context, symbol string) (int, error) {
+		return 200, nil
+	}
+	_, err = newOrder().Quantity("0.1").Price("30000").Do(newContext())
+	assertFilter(err, "MAX_NUM_ORDERS")
+}
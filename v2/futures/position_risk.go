@@ -23,6 +23,7 @@ func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v2/positionRisk",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
@@ -81,6 +82,7 @@ func (s *GetPositionRiskV3Service) Do(ctx context.Context, opts ...RequestOption
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v3/positionRisk",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
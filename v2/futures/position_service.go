@@ -193,6 +193,7 @@ func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/positionSide/dual",
+		weight:   30,
 		secType:  secTypeSigned,
 	}
 	r.setFormParams(params{})
@@ -252,6 +253,7 @@ func (s *GetMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/multiAssetsMargin",
+		weight:   30,
 		secType:  secTypeSigned,
 	}
 	r.setFormParams(params{})
@@ -51,6 +51,7 @@ func (piks *PremiumIndexKlinesService) Do(ctx context.Context, opts ...RequestOp
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/premiumIndexKlines",
+		weight:   klinesWeight(piks.limit),
 	}
 	r.setParam("symbol", piks.symbol)
 	r.setParam("interval", piks.interval)
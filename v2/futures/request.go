@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -15,6 +17,17 @@ const (
 	secTypeSigned
 )
 
+// common return the security type exposed to middlewares
+func (t secType) common() common.SecType {
+	switch t {
+	case secTypeAPIKey:
+		return common.SecTypeAPIKey
+	case secTypeSigned:
+		return common.SecTypeSigned
+	}
+	return common.SecTypeNone
+}
+
 type params map[string]interface{}
 
 // request define an API request
@@ -28,6 +41,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	weight     int64
 }
 
 // setParam set param with key/value to query string
@@ -84,6 +98,14 @@ func WithRecvWindow(recvWindow int64) RequestOption {
 	}
 }
 
+// WithWeight set the request weight checked by the client RateLimiter before the request is sent,
+// overriding the documented weight the service sets, 1 for the services without one
+func WithWeight(weight int64) RequestOption {
+	return func(r *request) {
+		r.weight = weight
+	}
+}
+
 // WithHeader set or add a header value to the request
 func WithHeader(key, value string, replace bool) RequestOption {
 	return func(r *request) {
@@ -0,0 +1,32 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Subscribe open a stream with serve on a copy of e, DefaultEnvironment() when nil, and deliver its messages,
+// errors and reconnections on a common.Stream. The stream is stopped when ctx is done, e.g.
+//
+//	stream, err := futures.Subscribe(ctx, env, func(e *futures.Environment, handler func(*futures.WsMarkPriceEvent), errHandler futures.ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return e.WsMarkPriceServe("BTCUSDT", handler, errHandler)
+//	})
+//
+// The events of the stream include the errors and the reconnections when WsReconnect is set:
+//
+//	for event := range stream.Events() {
+//		if event.Type == common.StreamEventGap {
+//			// resync
+//		}
+//	}
+func Subscribe[T any](ctx context.Context, e *Environment, serve func(e *Environment, handler func(event T), errHandler ErrHandler) (doneC, stopC chan struct{}, err error)) (*common.Stream[T], error) {
+	if e == nil {
+		e = DefaultEnvironment()
+	}
+	return common.NewStream(ctx, e.WsReconnect, func(handler func(event T), errHandler func(err error), reconnect *common.WsReconnect) (doneC, stopC chan struct{}, err error) {
+		env := *e
+		env.WsReconnect = reconnect
+		return serve(&env, handler, errHandler)
+	})
+}
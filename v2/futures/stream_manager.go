@@ -0,0 +1,122 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamManager subscribe the market streams of many symbols on a few shared connections,
+// the subscriptions change without reconnecting. The streams are named like on the exchange,
+// e.g. btcusdt@depth or btcusdt@kline_1m, see common.StreamMux.
+type StreamManager struct {
+	mux        *common.StreamMux
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined streams of the environment, errHandler receive
+// the errors of the connections and of the parsing of the messages.
+// The lost connections are reconnected with WsReconnect when it is set.
+func (e *Environment) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	cfg := common.StreamMuxConfig{
+		URL:               strings.TrimSuffix(e.CombinedWsURL, "?streams="),
+		MaxStreams:        1024,
+		MessagesPerSecond: 10,
+		Reconnect:         e.WsReconnect,
+		ErrHandler:        errHandler,
+	}
+	if e.ProxyURL != "" {
+		proxy := e.ProxyURL
+		cfg.Proxy = &proxy
+	}
+	return &StreamManager{mux: common.NewStreamMux(cfg), errHandler: errHandler}
+}
+
+// NewStreamManager init a StreamManager on the default environment
+func NewStreamManager(errHandler ErrHandler) *StreamManager {
+	return DefaultEnvironment().NewStreamManager(errHandler)
+}
+
+// streamNames return the streams of symbols with a suffix, e.g. @depth
+func streamNames(symbols []string, suffix string) []string {
+	streams := make([]string, len(symbols))
+	for i, s := range symbols {
+		streams[i] = strings.ToLower(s) + suffix
+	}
+	return streams
+}
+
+// Subscribe call handler with the raw data of streams
+func (m *StreamManager) Subscribe(handler common.StreamHandler, streams ...string) error {
+	return m.mux.Subscribe(handler, streams...)
+}
+
+// SubscribeDiffDepth subscribe the diff. depth streams of symbols, <symbol>@depth
+func (m *StreamManager) SubscribeDiffDepth(handler WsDepthHandler, symbols ...string) error {
+	return m.mux.Subscribe(m.depthHandler(handler), streamNames(symbols, "@depth")...)
+}
+
+// SubscribePartialDepth subscribe the partial depth streams of symbols with 5, 10 or 20 levels, <symbol>@depth<levels>
+func (m *StreamManager) SubscribePartialDepth(handler WsDepthHandler, levels int, symbols ...string) error {
+	if levels != 5 && levels != 10 && levels != 20 {
+		return errors.New("Invalid levels")
+	}
+	return m.mux.Subscribe(m.depthHandler(handler), streamNames(symbols, fmt.Sprintf("@depth%d", levels))...)
+}
+
+func (m *StreamManager) depthHandler(handler WsDepthHandler) common.StreamHandler {
+	return func(stream string, data []byte) {
+		j, err := newJSON(data)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(newWsDepthEvent(j))
+	}
+}
+
+// SubscribeKline subscribe the kline streams of symbols with an interval like 15m, <symbol>@kline_<interval>
+func (m *StreamManager) SubscribeKline(handler WsKlineHandler, interval string, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@kline_"+interval)...)
+}
+
+// SubscribeAggTrade subscribe the aggregate trade streams of symbols, <symbol>@aggTrade.
+// The futures have no stream of the individual trades.
+func (m *StreamManager) SubscribeAggTrade(handler WsAggTradeHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@aggTrade")...)
+}
+
+// SubscribeBookTicker subscribe the best bid and ask streams of symbols, <symbol>@bookTicker
+func (m *StreamManager) SubscribeBookTicker(handler WsBookTickerHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@bookTicker")...)
+}
+
+// SubscribeTicker subscribe the 24hr statistics streams of symbols, <symbol>@ticker
+func (m *StreamManager) SubscribeTicker(handler WsMarketTickerHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@ticker")...)
+}
+
+// Unsubscribe stop streams, e.g. btcusdt@depth
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	return m.mux.Unsubscribe(streams...)
+}
+
+// ListSubscriptions return the streams subscribed according to the server
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	return m.mux.ListSubscriptions()
+}
+
+// Streams return the subscribed streams
+func (m *StreamManager) Streams() []string {
+	return m.mux.Streams()
+}
+
+// Close close the connections
+func (m *StreamManager) Close() {
+	m.mux.Close()
+}
@@ -25,9 +25,11 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/ticker/bookTicker",
+		weight:   5,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 2
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	data = common.ToJSONList(data)
@@ -68,9 +70,11 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v2/ticker/price",
+		weight:   2,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -108,9 +112,11 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/ticker/24hr",
+		weight:   40,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 1
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -37,6 +37,7 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/historicalTrades",
+		weight:   20,
 		secType:  secTypeAPIKey,
 	}
 	r.setParam("symbol", s.symbol)
@@ -130,6 +131,7 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/aggTrades",
+		weight:   20,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.fromID != nil {
@@ -191,6 +193,7 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/trades",
+		weight:   5,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
@@ -260,6 +263,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/userTrades",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
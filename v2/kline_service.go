@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // KlinesService list klines
@@ -51,6 +54,7 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/klines",
+		weight:   2,
 	}
 	r.setParam("symbol", s.symbol)
 	r.setParam("interval", s.interval)
@@ -96,6 +100,27 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	return res, nil
 }
 
+// Iterator iterate over the klines from StartTime, or the first kline, until EndTime or now
+func (s *KlinesService) Iterator(opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.TimePager[*Kline]{
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Now:       s.c.serverTimestamp,
+		Fetch: func(ctx context.Context, start, end int64) ([]*Kline, bool, error) {
+			p := *s
+			p.startTime, p.endTime, p.limit = &start, &end, &limit
+			klines, err := p.Do(ctx, opts...)
+			return klines, len(klines) >= limit, err
+		},
+		Time: func(k *Kline) int64 { return k.OpenTime },
+		Key:  func(k *Kline) string { return strconv.FormatInt(k.OpenTime, 10) },
+	}.Pages())
+}
+
 // Kline define kline info
 type Kline struct {
 	OpenTime                 int64  `json:"openTime"`
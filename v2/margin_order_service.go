@@ -24,6 +24,7 @@ type CreateMarginOrderService struct {
 	sideEffectType   *SideEffectType
 	timeInForce      *TimeInForceType
 	isIsolated       *bool
+	validator        *OrderValidator
 }
 
 // Symbol set symbol
@@ -106,6 +107,9 @@ func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType)
 
 // Do send request
 func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	if err = s.validate(ctx); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/sapi/v1/margin/order",
@@ -16,6 +16,7 @@ func (s *AccountService) Do(ctx context.Context, opts ...RequestOption) (res *Ac
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/account",
+		weight:   3,
 		secType:  secTypeSigned,
 	}
 
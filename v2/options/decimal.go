@@ -0,0 +1,682 @@
+package options
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// MarginBalanceDecimal parse MarginBalance as a decimal, see common.ParseDecimal
+func (a *Asset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.MarginBalance)
+}
+
+// EquityDecimal parse Equity as a decimal, see common.ParseDecimal
+func (a *Asset) EquityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Equity)
+}
+
+// AvailableDecimal parse Available as a decimal, see common.ParseDecimal
+func (a *Asset) AvailableDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Available)
+}
+
+// LockedDecimal parse Locked as a decimal, see common.ParseDecimal
+func (a *Asset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(a.Locked)
+}
+
+// StrikePriceDecimal parse StrikePrice as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.StrikePrice)
+}
+
+// MakerFeeRateDecimal parse MakerFeeRate as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MakerFeeRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MakerFeeRate)
+}
+
+// TakerFeeRateDecimal parse TakerFeeRate as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) TakerFeeRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.TakerFeeRate)
+}
+
+// MinQtyDecimal parse MinQty as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MinQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MinQty)
+}
+
+// MaxQtyDecimal parse MaxQty as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaxQty)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.InitialMargin)
+}
+
+// MaintenanceMarginDecimal parse MaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MaintenanceMargin)
+}
+
+// MinInitialMarginDecimal parse MinInitialMargin as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MinInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MinInitialMargin)
+}
+
+// MinMaintenanceMarginDecimal parse MinMaintenanceMargin as a decimal, see common.ParseDecimal
+func (s *OptionSymbol) MinMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(s.MinMaintenanceMargin)
+}
+
+// MaxQuantityDecimal parse MaxQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MaxQuantity)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.MinQuantity)
+}
+
+// StepSizeDecimal parse StepSize as a decimal, see common.ParseDecimal
+func (l *LotSizeFilter) StepSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.StepSize)
+}
+
+// MaxPriceDecimal parse MaxPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MaxPrice)
+}
+
+// MinPriceDecimal parse MinPrice as a decimal, see common.ParseDecimal
+func (p *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MinPrice)
+}
+
+// TickSizeDecimal parse TickSize as a decimal, see common.ParseDecimal
+func (p *PriceFilter) TickSizeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.TickSize)
+}
+
+// StrikePriceDecimal parse StrikePrice as a decimal, see common.ParseDecimal
+func (e *ExerciseHistory) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.StrikePrice)
+}
+
+// RealStrikePriceDecimal parse RealStrikePrice as a decimal, see common.ParseDecimal
+func (e *ExerciseHistory) RealStrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.RealStrikePrice)
+}
+
+// IndexPriceDecimal parse IndexPrice as a decimal, see common.ParseDecimal
+func (i *Index) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(i.IndexPrice)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Volume)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (k *Kline) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.Amount)
+}
+
+// TakerVolumeDecimal parse TakerVolume as a decimal, see common.ParseDecimal
+func (k *Kline) TakerVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerVolume)
+}
+
+// TakerAmountDecimal parse TakerAmount as a decimal, see common.ParseDecimal
+func (k *Kline) TakerAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(k.TakerAmount)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (m *Mark) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.MarkPrice)
+}
+
+// BidIVDecimal parse BidIV as a decimal, see common.ParseDecimal
+func (m *Mark) BidIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.BidIV)
+}
+
+// AskIVDecimal parse AskIV as a decimal, see common.ParseDecimal
+func (m *Mark) AskIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.AskIV)
+}
+
+// HighPriceLimitDecimal parse HighPriceLimit as a decimal, see common.ParseDecimal
+func (m *Mark) HighPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.HighPriceLimit)
+}
+
+// LowPriceLimitDecimal parse LowPriceLimit as a decimal, see common.ParseDecimal
+func (m *Mark) LowPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.LowPriceLimit)
+}
+
+// RiskFreeInterestDecimal parse RiskFreeInterest as a decimal, see common.ParseDecimal
+func (m *Mark) RiskFreeInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(m.RiskFreeInterest)
+}
+
+// SumOpenInterestDecimal parse SumOpenInterest as a decimal, see common.ParseDecimal
+func (o *OpenInterest) SumOpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.SumOpenInterest)
+}
+
+// SumOpenInterestUsdDecimal parse SumOpenInterestUsd as a decimal, see common.ParseDecimal
+func (o *OpenInterest) SumOpenInterestUsdDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.SumOpenInterestUsd)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (l *LastTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Price)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (l *LastTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(l.Qty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (o *Order) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Quantity)
+}
+
+// ExecutedQtyDecimal parse ExecutedQty as a decimal, see common.ParseDecimal
+func (o *Order) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQty)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (o *Order) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.Fee)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (o *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(o.AvgPrice)
+}
+
+// EntryPriceDecimal parse EntryPrice as a decimal, see common.ParseDecimal
+func (p *Position) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.EntryPrice)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (p *Position) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Quantity)
+}
+
+// ReducibleQtyDecimal parse ReducibleQty as a decimal, see common.ParseDecimal
+func (p *Position) ReducibleQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.ReducibleQty)
+}
+
+// MarkValueDecimal parse MarkValue as a decimal, see common.ParseDecimal
+func (p *Position) MarkValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkValue)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (p *Position) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.MarkPrice)
+}
+
+// StrikePriceDecimal parse StrikePrice as a decimal, see common.ParseDecimal
+func (p *Position) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.StrikePrice)
+}
+
+// PositionCostDecimal parse PositionCost as a decimal, see common.ParseDecimal
+func (p *Position) PositionCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.PositionCost)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (u *UserTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (u *UserTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Quantity)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (u *UserTrade) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.Fee)
+}
+
+// RealizedProfitDecimal parse RealizedProfit as a decimal, see common.ParseDecimal
+func (u *UserTrade) RealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(u.RealizedProfit)
+}
+
+// ExercisePriceDecimal parse ExercisePrice as a decimal, see common.ParseDecimal
+func (e *ExerciseRecord) ExercisePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.ExercisePrice)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (e *ExerciseRecord) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.MarkPrice)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (e *ExerciseRecord) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.Quantity)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (e *ExerciseRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.Amount)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (e *ExerciseRecord) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(e.Fee)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (b *Bill) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(b.Amount)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (t *Ticker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.PriceChange)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (t *Ticker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.PriceChangePercent)
+}
+
+// LastPriceDecimal parse LastPrice as a decimal, see common.ParseDecimal
+func (t *Ticker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.LastPrice)
+}
+
+// LastQtyDecimal parse LastQty as a decimal, see common.ParseDecimal
+func (t *Ticker) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.LastQty)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (t *Ticker) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (t *Ticker) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (t *Ticker) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (t *Ticker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Volume)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (t *Ticker) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Amount)
+}
+
+// BidPriceDecimal parse BidPrice as a decimal, see common.ParseDecimal
+func (t *Ticker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.BidPrice)
+}
+
+// AskPriceDecimal parse AskPrice as a decimal, see common.ParseDecimal
+func (t *Ticker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.AskPrice)
+}
+
+// StrikePriceDecimal parse StrikePrice as a decimal, see common.ParseDecimal
+func (t *Ticker) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.StrikePrice)
+}
+
+// ExercisePriceDecimal parse ExercisePrice as a decimal, see common.ParseDecimal
+func (t *Ticker) ExercisePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.ExercisePrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (t *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (t *Trade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.Qty)
+}
+
+// QuoteQtyDecimal parse QuoteQty as a decimal, see common.ParseDecimal
+func (t *Trade) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (h *HistoricalTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(h.Price)
+}
+
+// QtyDecimal parse Qty as a decimal, see common.ParseDecimal
+func (h *HistoricalTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(h.Qty)
+}
+
+// QuoteQtyDecimal parse QuoteQty as a decimal, see common.ParseDecimal
+func (h *HistoricalTrade) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(h.QuoteQty)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsIndexEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal parse QuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.QuoteVolume)
+}
+
+// TakerVolumeDecimal parse TakerVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) TakerVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.TakerVolume)
+}
+
+// TakerQuoteVolumeDecimal parse TakerQuoteVolume as a decimal, see common.ParseDecimal
+func (w *WsKline) TakerQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.TakerQuoteVolume)
+}
+
+// OpenDecimal parse Open as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Open)
+}
+
+// HighDecimal parse High as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.High)
+}
+
+// LowDecimal parse Low as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Low)
+}
+
+// CloseDecimal parse Close as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Close)
+}
+
+// VolumeDecimal parse Volume as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Volume)
+}
+
+// AmountDecimal parse Amount as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Amount)
+}
+
+// PriceChangePercentDecimal parse PriceChangePercent as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChangePercent)
+}
+
+// PriceChangeDecimal parse PriceChange as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.PriceChange)
+}
+
+// LastQtyDecimal parse LastQty as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LastQty)
+}
+
+// BidOpenPriceDecimal parse BidOpenPrice as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) BidOpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidOpenPrice)
+}
+
+// AskOpenPriceDecimal parse AskOpenPrice as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) AskOpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AskOpenPrice)
+}
+
+// BidQtyDecimal parse BidQty as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidQty)
+}
+
+// AskQtyDecimal parse AskQty as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AskQty)
+}
+
+// BidIVDecimal parse BidIV as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) BidIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.BidIV)
+}
+
+// AskIVDecimal parse AskIV as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) AskIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AskIV)
+}
+
+// MarkPriceDecimal parse MarkPrice as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MarkPrice)
+}
+
+// HighPriceLimitDecimal parse HighPriceLimit as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) HighPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.HighPriceLimit)
+}
+
+// LowPriceLimitDecimal parse LowPriceLimit as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) LowPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.LowPriceLimit)
+}
+
+// ExercisPriceDecimal parse ExercisPrice as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) ExercisPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ExercisPrice)
+}
+
+// RiskFreeInterestDecimal parse RiskFreeInterest as a decimal, see common.ParseDecimal
+func (w *WsTickerEvent) RiskFreeInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.RiskFreeInterest)
+}
+
+// OpenInterestDecimal parse OpenInterest as a decimal, see common.ParseDecimal
+func (w *WsOpenInterestEvent) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.OpenInterest)
+}
+
+// MinQuantityDecimal parse MinQuantity as a decimal, see common.ParseDecimal
+func (w *WsOptionPairEvent) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MinQuantity)
+}
+
+// StrikePriceDecimal parse StrikePrice as a decimal, see common.ParseDecimal
+func (w *WsOptionPairEvent) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.StrikePrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (p *PL) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (p *PL) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(p.Quantity)
+}
+
+// BalanceDecimal parse Balance as a decimal, see common.ParseDecimal
+func (w *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Balance)
+}
+
+// UnrealizedPnLDecimal parse UnrealizedPnL as a decimal, see common.ParseDecimal
+func (w *WsBalance) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.UnrealizedPnL)
+}
+
+// MaintenanceMarginDecimal parse MaintenanceMargin as a decimal, see common.ParseDecimal
+func (w *WsBalance) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.MaintenanceMargin)
+}
+
+// InitialMarginDecimal parse InitialMargin as a decimal, see common.ParseDecimal
+func (w *WsBalance) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.InitialMargin)
+}
+
+// CountQtyDecimal parse CountQty as a decimal, see common.ParseDecimal
+func (w *WsPosition) CountQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.CountQty)
+}
+
+// ReduleQtyDecimal parse ReduleQty as a decimal, see common.ParseDecimal
+func (w *WsPosition) ReduleQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ReduleQty)
+}
+
+// AvgPriceDecimal parse AvgPrice as a decimal, see common.ParseDecimal
+func (w *WsPosition) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.AvgPrice)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsFilled) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsFilled) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (w *WsFilled) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Fee)
+}
+
+// PriceDecimal parse Price as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Price)
+}
+
+// QuantityDecimal parse Quantity as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Quantity)
+}
+
+// ExecutedQtyDecimal parse ExecutedQty as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ExecutedQty)
+}
+
+// ExecutedCostDecimal parse ExecutedCost as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) ExecutedCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.ExecutedCost)
+}
+
+// FeeDecimal parse Fee as a decimal, see common.ParseDecimal
+func (w *WsOrderTradeUpdate) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal(w.Fee)
+}
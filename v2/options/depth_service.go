@@ -31,6 +31,7 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/depth",
+		weight:   depthWeight(s.limit),
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
@@ -68,6 +69,23 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	return res, nil
 }
 
+// depthWeight return the weight of the order book at limit, 100 by default
+func depthWeight(limit *int) int64 {
+	l := 100
+	if limit != nil {
+		l = *limit
+	}
+	switch {
+	case l <= 50:
+		return 2
+	case l <= 100:
+		return 5
+	case l <= 500:
+		return 10
+	}
+	return 20
+}
+
 // DepthResponse define depth info with bids and asks
 type DepthResponse struct {
 	TradeTime int64 `json:"T"`
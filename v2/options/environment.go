@@ -0,0 +1,96 @@
+package options
+
+import (
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Environment define the endpoints and connection settings used by a client and its websocket streams.
+// Clients and streams created from different environments can be used side by side,
+// e.g. to talk to the mainnet and the testnet in the same process.
+type Environment struct {
+	// APIURL is the base URL of the REST API
+	APIURL string
+	// WsURL is the base URL of the websocket streams
+	WsURL string
+	// CombinedWsURL is the base URL of the combined websocket streams
+	CombinedWsURL string
+	// ProxyURL is used by the REST client and the websocket connections,
+	// HTTP_PROXY and HTTPS_PROXY are used when empty
+	ProxyURL string
+	// WsKeepalive enables sending ping/pong messages to check the connection stability
+	WsKeepalive bool
+	// WsTimeout is an interval for sending ping/pong messages if WsKeepalive is enabled
+	WsTimeout time.Duration
+	// HTTPTimeout is the timeout of REST requests, 0 means no timeout
+	HTTPTimeout time.Duration
+	// WsMiddlewares wrap the opening of the websocket streams, e.g. to record or replay them,
+	// the first middleware is the outermost one
+	WsMiddlewares []common.WsMiddleware
+	// WsReconnect reconnects the websocket streams when their connection is lost, e.g. after the
+	// 24h limit of the connections. The streams stop on the first error when nil.
+	WsReconnect *common.WsReconnect
+	// WsDispatcher queues the messages of the websocket streams for their handlers so that a slow
+	// handler does not stall the reading of the connection. The handlers are called by the reading
+	// goroutine when nil.
+	WsDispatcher *common.WsDispatcher
+}
+
+// MainnetEnvironment return the production environment
+func MainnetEnvironment() *Environment {
+	return &Environment{
+		APIURL:        baseApiMainUrl,
+		WsURL:         baseWsMainUrl,
+		CombinedWsURL: baseCombinedMainURL,
+		WsTimeout:     60 * time.Second,
+	}
+}
+
+// TestnetEnvironment return the testnet environment, the options websocket testnet endpoints are unknown yet
+func TestnetEnvironment() *Environment {
+	return &Environment{
+		APIURL:        baseApiTestnetUrl,
+		WsURL:         baseWsTestnetUrl,
+		CombinedWsURL: baseCombinedTestnetURL,
+		WsTimeout:     60 * time.Second,
+	}
+}
+
+// DefaultEnvironment return the environment configured by the package level variables
+// UseTestnet, ProxyUrl, WebsocketKeepalive, WebsocketTimeout, WebsocketReconnect and
+// WebsocketDispatcher.
+// It is used by NewClient and the package level websocket functions.
+func DefaultEnvironment() *Environment {
+	e := MainnetEnvironment()
+	if UseTestnet {
+		// UseTestnet only ever switched the websocket streams
+		e = TestnetEnvironment()
+		e.APIURL = baseApiMainUrl
+	}
+	e.ProxyURL = ProxyUrl
+	e.WsKeepalive = WebsocketKeepalive
+	e.WsTimeout = WebsocketTimeout
+	e.WsReconnect = WebsocketReconnect
+	e.WsDispatcher = WebsocketDispatcher
+	return e
+}
+
+func (e *Environment) newWsConfig(endpoint string) *WsConfig {
+	cfg := &WsConfig{
+		Endpoint:  endpoint,
+		Keepalive: e.WsKeepalive,
+		Timeout:   e.WsTimeout,
+	}
+	cfg.Middlewares = e.WsMiddlewares
+	cfg.Reconnect = e.WsReconnect
+	cfg.Dispatcher = e.WsDispatcher
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultWsTimeout
+	}
+	if e.ProxyURL != "" {
+		proxy := e.ProxyURL
+		cfg.Proxy = &proxy
+	}
+	return cfg
+}
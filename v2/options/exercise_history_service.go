@@ -49,6 +49,7 @@ func (s *ExerciseHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/exerciseHistory",
+		weight:   3,
 	}
 	if s.underlying != nil {
 		r.setParam("underlying", s.underlying)
@@ -38,6 +38,7 @@ func (s *MarkService) Do(ctx context.Context, opts ...RequestOption) (res []*Mar
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/mark",
+		weight:   5,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
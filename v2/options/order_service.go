@@ -239,10 +239,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/openOrders",
+		weight:   40,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
+		r.weight = 1
 	}
 	if s.orderId != nil {
 		r.setParam("orderId", *s.orderId)
@@ -506,6 +508,7 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/eapi/v1/batchOrders",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -665,6 +668,7 @@ func (s *HistoryOrdersService) Do(ctx context.Context, opts ...RequestOption) (r
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/historyOrders",
+		weight:   3,
 		secType:  secTypeSigned,
 	}
 
@@ -733,6 +737,7 @@ func (s *PositionService) Do(ctx context.Context, opts ...RequestOption) (res []
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/position",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -814,6 +819,7 @@ func (s *UserTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/userTrades",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -898,6 +904,7 @@ func (s *ExerciseRecordService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/exerciseRecord",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -1033,6 +1040,7 @@ func (s *IncomeDownloadIdService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/income/asyn",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
@@ -1079,6 +1087,7 @@ func (s *IncomeDownloadLinkService) Do(ctx context.Context, opts ...RequestOptio
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/income/id",
+		weight:   5,
 		secType:  secTypeSigned,
 	}
 
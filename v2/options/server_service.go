@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // PingService ping server
@@ -48,3 +51,22 @@ func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serv
 	serverTime = j.Get("serverTime").MustInt64()
 	return serverTime, nil
 }
+
+// StartTimeSync keep TimeOffset in sync with the server time in the background, syncing every interval
+// and again as soon as a request fails with -1021. Call Stop on the returned TimeSync to stop syncing.
+// The TimeSync started by a previous call, or set in TimeSync, is stopped.
+func (c *Client) StartTimeSync(interval time.Duration) *common.TimeSync {
+	ts := common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	}, interval, &c.TimeOffset)
+	c.timeSyncMu.Lock()
+	prev := c.TimeSync
+	c.TimeSync = ts
+	c.timeSyncMu.Unlock()
+	// stopped outside of the lock, its last sync may be a request reading TimeSync
+	if prev != nil {
+		prev.Stop()
+	}
+	ts.Start()
+	return ts
+}
@@ -0,0 +1,26 @@
+package options
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Subscribe open a stream with serve on a copy of e, DefaultEnvironment() when nil, and deliver its messages,
+// errors and reconnections on a common.Stream. The stream is stopped when ctx is done, e.g.
+//
+//	stream, err := options.Subscribe(ctx, env, func(e *options.Environment, handler func(*options.WsTradeEvent), errHandler options.ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return e.WsTradeServe("ETH", handler, errHandler)
+//	})
+//
+// Stream.Next skips the errors and the connection changes, Stream.Events returns all of them.
+func Subscribe[T any](ctx context.Context, e *Environment, serve func(e *Environment, handler func(event T), errHandler ErrHandler) (doneC, stopC chan struct{}, err error)) (*common.Stream[T], error) {
+	if e == nil {
+		e = DefaultEnvironment()
+	}
+	return common.NewStream(ctx, e.WsReconnect, func(handler func(event T), errHandler func(err error), reconnect *common.WsReconnect) (doneC, stopC chan struct{}, err error) {
+		env := *e
+		env.WsReconnect = reconnect
+		return serve(&env, handler, errHandler)
+	})
+}
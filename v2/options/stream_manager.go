@@ -0,0 +1,124 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamManager subscribe the market streams of many options on a few shared connections,
+// the subscriptions change without reconnecting. The streams are named like on the exchange,
+// with upper case symbols, e.g. BTC-240628-60000-C@trade or ETH@markPrice, see common.StreamMux.
+type StreamManager struct {
+	mux        *common.StreamMux
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined streams of the environment, errHandler receive
+// the errors of the connections and of the parsing of the messages.
+// The lost connections are reconnected with WsReconnect when it is set.
+func (e *Environment) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	cfg := common.StreamMuxConfig{
+		URL:               strings.TrimSuffix(e.CombinedWsURL, "?streams="),
+		MaxStreams:        200,
+		MessagesPerSecond: 10,
+		Reconnect:         e.WsReconnect,
+		ErrHandler:        errHandler,
+	}
+	if e.ProxyURL != "" {
+		proxy := e.ProxyURL
+		cfg.Proxy = &proxy
+	}
+	return &StreamManager{mux: common.NewStreamMux(cfg), errHandler: errHandler}
+}
+
+// NewStreamManager init a StreamManager on the default environment
+func NewStreamManager(errHandler ErrHandler) *StreamManager {
+	return DefaultEnvironment().NewStreamManager(errHandler)
+}
+
+// streamNames return the streams of symbols with a suffix, e.g. @trade
+func streamNames(symbols []string, suffix string) []string {
+	streams := make([]string, len(symbols))
+	for i, s := range symbols {
+		streams[i] = strings.ToUpper(s) + suffix
+	}
+	return streams
+}
+
+// parseHandler return a StreamHandler parsing the messages with parse, the errors go to errHandler
+func parseHandler[T any](parse func(message []byte) (T, error), handler func(event T), errHandler ErrHandler) common.StreamHandler {
+	return func(stream string, data []byte) {
+		event, err := parse(data)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+}
+
+// Subscribe call handler with the raw data of streams
+func (m *StreamManager) Subscribe(handler common.StreamHandler, streams ...string) error {
+	return m.mux.Subscribe(handler, streams...)
+}
+
+// SubscribeTrade subscribe the trade streams of option symbols, <symbol>@trade
+func (m *StreamManager) SubscribeTrade(handler WsTradeHandler, symbols ...string) error {
+	return m.mux.Subscribe(parseHandler(wsTradeParse, handler, m.errHandler), streamNames(symbols, "@trade")...)
+}
+
+// SubscribeIndex subscribe the index price streams of underlyings like BTCUSDT, <underlying>@index
+func (m *StreamManager) SubscribeIndex(handler WsIndexHandler, underlyings ...string) error {
+	return m.mux.Subscribe(parseHandler(wsIndexParse, handler, m.errHandler), streamNames(underlyings, "@index")...)
+}
+
+// SubscribeMarkPrice subscribe the mark price streams of the options of underlyings like ETH, <underlying>@markPrice
+func (m *StreamManager) SubscribeMarkPrice(handler WsMarkPriceHandler, underlyings ...string) error {
+	return m.mux.Subscribe(parseHandler(wsMarkPriceParse, handler, m.errHandler), streamNames(underlyings, "@markPrice")...)
+}
+
+// SubscribeKline subscribe the kline streams of option symbols with an interval like 15m, <symbol>@kline_<interval>
+func (m *StreamManager) SubscribeKline(handler WsKlineHandler, interval string, symbols ...string) error {
+	return m.mux.Subscribe(parseHandler(wsKlineParse, handler, m.errHandler), streamNames(symbols, "@kline_"+interval)...)
+}
+
+// SubscribeTicker subscribe the 24hr statistics streams of option symbols, <symbol>@ticker
+func (m *StreamManager) SubscribeTicker(handler WsTickerHandler, symbols ...string) error {
+	return m.mux.Subscribe(parseHandler(wsTickerParse, handler, m.errHandler), streamNames(symbols, "@ticker")...)
+}
+
+// SubscribeDepth subscribe the depth streams of option symbols with 10, 20, 50, 100 or 1000 levels,
+// <symbol>@depth<levels>
+func (m *StreamManager) SubscribeDepth(handler WsDepthHandler, levels string, symbols ...string) error {
+	switch levels {
+	case "10", "20", "50", "100", "1000":
+	default:
+		return fmt.Errorf("invalid level %s", levels)
+	}
+	return m.mux.Subscribe(parseHandler(wsDepthParse, handler, m.errHandler), streamNames(symbols, "@depth"+levels)...)
+}
+
+// Unsubscribe stop streams, e.g. BTC-240628-60000-C@trade
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	return m.mux.Unsubscribe(streams...)
+}
+
+// ListSubscriptions return the streams subscribed according to the server
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	return m.mux.ListSubscriptions()
+}
+
+// Streams return the subscribed streams
+func (m *StreamManager) Streams() []string {
+	return m.mux.Streams()
+}
+
+// Close close the connections
+func (m *StreamManager) Close() {
+	m.mux.Close()
+}
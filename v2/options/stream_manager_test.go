@@ -0,0 +1,70 @@
+package options
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamManager(t *testing.T) {
+	messages := map[string]string{
+		"@trade":     `{"e":"trade","E":1,"s":"BTC-240628-60000-C","t":"1","p":"1000","q":"0.1"}`,
+		"@markPrice": `[{"e":"markPrice","E":1,"s":"ETH-240628-3000-P","mp":"55.5"}]`,
+		"@depth10":   `{"e":"depth","E":1,"T":2,"s":"BTC-240628-60000-C","u":160,"pu":149,"b":[["1000","0.1"]],"a":[["1010","0.2"]]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			var req struct {
+				Params []string `json:"params"`
+				ID     int64    `json:"id"`
+			}
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			c.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID})
+			for _, stream := range req.Params {
+				data := messages[stream[strings.Index(stream, "@"):]]
+				c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"`+stream+`","data":`+data+`}`))
+			}
+		}
+	}))
+	defer server.Close()
+	env := MainnetEnvironment()
+	env.CombinedWsURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/stream?streams="
+	m := env.NewStreamManager(nil)
+	defer m.Close()
+
+	events := make(chan interface{}, 1)
+	next := func() interface{} {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event")
+			return nil
+		}
+	}
+	assert.Error(t, m.SubscribeDepth(func(event *WsDepthEvent) {}, "5", "BTC-240628-60000-C"))
+	require.NoError(t, m.SubscribeDepth(func(event *WsDepthEvent) { events <- event }, "10", "btc-240628-60000-c"))
+	depth := next().(*WsDepthEvent)
+	assert.Equal(t, int64(149), depth.PrevLastUpdateID)
+	assert.Equal(t, []Bid{{Price: "1000", Quantity: "0.1"}}, depth.Bids)
+	require.NoError(t, m.SubscribeTrade(func(event *WsTradeEvent) { events <- event }, "BTC-240628-60000-C"))
+	assert.Equal(t, "1000", next().(*WsTradeEvent).Price)
+	require.NoError(t, m.SubscribeMarkPrice(func(res []*WsMarkPriceEvent) { events <- res }, "ETH"))
+	assert.Equal(t, "55.5", next().([]*WsMarkPriceEvent)[0].MarkPrice)
+
+	require.NoError(t, m.Unsubscribe("ETH@markPrice"))
+	assert.Equal(t, []string{"BTC-240628-60000-C@depth10", "BTC-240628-60000-C@trade"}, m.Streams())
+}
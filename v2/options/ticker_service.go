@@ -44,6 +44,7 @@ func (s *TickerService) Do(ctx context.Context, opts ...RequestOption) (res []*T
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/ticker",
+		weight:   5,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
@@ -41,6 +41,7 @@ func (s *TradesService) Do(ctx context.Context, opts ...RequestOption) (res []*T
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/trades",
+		weight:   5,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
@@ -89,6 +90,7 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/eapi/v1/historicalTrades",
+		weight:   20,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
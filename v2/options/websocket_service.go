@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -21,39 +23,23 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect reconnects the websocket streams when their connection is lost, disabled when nil
+	WebsocketReconnect *common.WsReconnect
+	// WebsocketDispatcher queues the messages of the websocket streams for their handlers, disabled when nil
+	WebsocketDispatcher *common.WsDispatcher
 	// UseTestnet switch all the WS streams from production to the testnet
+	//
+	// Deprecated: pass TestnetEnvironment() to NewClient and use the websocket functions of the Environment,
+	// UseTestnet is only read by DefaultEnvironment.
 	UseTestnet = false
 
 	ProxyUrl = ""
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
-	if UseTestnet {
-		return baseWsTestnetUrl
-	}
-	return baseWsMainUrl
-}
-
-func getWsProxyUrl() *string {
-	if ProxyUrl == "" {
-		return nil
-	}
-	return &ProxyUrl
-}
-
 func SetWsProxyUrl(url string) {
 	ProxyUrl = url
 }
 
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
-	if UseTestnet {
-		return baseCombinedTestnetURL
-	}
-	return baseCombinedMainURL
-}
-
 type WsTradeEvent struct {
 	Event     string `json:"e"`
 	Time      int64  `json:"E"`
@@ -209,15 +195,20 @@ func wsTradeServeHandler(message []byte, handler WsTradeHandler, errHandler ErrH
 }
 
 // WsTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@trade", e.WsURL, strings.ToUpper(symbol))
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsTradeServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsTradeServe serve websocket that push trade information that is aggregated for a single taker order.
+func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsTradeServe(symbol, handler, errHandler)
+}
+
 func wsIndexParse(message []byte) (*WsIndexEvent, error) {
 	event := new(WsIndexEvent)
 	err := json.Unmarshal(message, event)
@@ -237,15 +228,20 @@ func wsIndexServeHandler(message []byte, handler WsIndexHandler, errHandler ErrH
 }
 
 // WsIndexServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsIndexServe(symbol string, handler WsIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@index", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsIndexServe(symbol string, handler WsIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@index", e.WsURL, strings.ToUpper(symbol))
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsIndexServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsIndexServe serve websocket that push trade information that is aggregated for a single taker order.
+func WsIndexServe(symbol string, handler WsIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsIndexServe(symbol, handler, errHandler)
+}
+
 func wsMarkPriceParse(message []byte) ([]*WsMarkPriceEvent, error) {
 	event := make([]*WsMarkPriceEvent, 0)
 	err := json.Unmarshal(message, &event)
@@ -264,15 +260,19 @@ func wsMarkPriceServeHandler(message []byte, handler WsMarkPriceHandler, errHand
 	handler(event)
 }
 
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", e.WsURL, strings.ToUpper(symbol))
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsMarkPriceServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsMarkPriceServe(symbol, handler, errHandler)
+}
+
 func wsKlineParse(message []byte) (*WsKlineEvent, error) {
 	event := new(WsKlineEvent)
 	err := json.Unmarshal(message, event)
@@ -291,15 +291,19 @@ func wsKlineServeHandler(message []byte, handler WsKlineHandler, errHandler ErrH
 	handler(event)
 }
 
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToUpper(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", e.WsURL, strings.ToUpper(symbol), interval)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsKlineServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsKlineServe(symbol, interval, handler, errHandler)
+}
+
 func wsTickerParse(message []byte) ([]*WsTickerEvent, error) {
 	event := make([]*WsTickerEvent, 1)
 	err := json.Unmarshal(message, &event[0])
@@ -318,15 +322,19 @@ func wsTickerServeHandler(message []byte, handler WsTickerHandler, errHandler Er
 	handler(event)
 }
 
-func WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", e.WsURL, strings.ToUpper(symbol))
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsTickerServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+func WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsTickerServe(symbol, handler, errHandler)
+}
+
 func wsTickerExpireParse(message []byte) ([]*WsTickerEvent, error) {
 	event := make([]*WsTickerEvent, 0)
 	err := json.Unmarshal(message, &event)
@@ -347,15 +355,21 @@ func wsTickerExpireServeHandler(message []byte, handler WsTickerHandler, errHand
 
 // expireDate: for example 220930
 // underlying: for example ETH
-func WsTickerWithExpireServe(underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker@%s", getWsEndpoint(), strings.ToUpper(underlying), expireDate)
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsTickerWithExpireServe(underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker@%s", e.WsURL, strings.ToUpper(underlying), expireDate)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsTickerExpireServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// expireDate: for example 220930
+// underlying: for example ETH
+func WsTickerWithExpireServe(underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsTickerWithExpireServe(underlying, expireDate, handler, errHandler)
+}
+
 func wsOpenInterestParse(message []byte) ([]*WsOpenInterestEvent, error) {
 	event := make([]*WsOpenInterestEvent, 0)
 	err := json.Unmarshal(message, &event)
@@ -376,15 +390,21 @@ func wsOpenInterestServeHandler(message []byte, handler WsOpenInterestHandler, e
 
 // expireDate: for example 220930
 // underlying: for example ETH
-func WsOpenInterestServe(underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@openInterest@%s", getWsEndpoint(), strings.ToUpper(underlying), expireDate)
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsOpenInterestServe(underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@openInterest@%s", e.WsURL, strings.ToUpper(underlying), expireDate)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsOpenInterestServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// expireDate: for example 220930
+// underlying: for example ETH
+func WsOpenInterestServe(underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsOpenInterestServe(underlying, expireDate, handler, errHandler)
+}
+
 func wsOptionPairParse(message []byte) (*WsOptionPairEvent, error) {
 	event := new(WsOptionPairEvent)
 	err := json.Unmarshal(message, event)
@@ -403,15 +423,19 @@ func wsOptionPairServeHandler(message []byte, handler WsOptionPairHandler, errHa
 	handler(event)
 }
 
-func WsOptionPairServe(handler WsOptionPairHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/option_pair", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsOptionPairServe(handler WsOptionPairHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/option_pair", e.WsURL)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsOptionPairServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+func WsOptionPairServe(handler WsOptionPairHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsOptionPairServe(handler, errHandler)
+}
+
 func wsDepthParse(message []byte) (*WsDepthEvent, error) {
 	j, err := newJSON(message)
 	if err != nil {
@@ -456,7 +480,7 @@ func wsDepthServeHandler(message []byte, handler WsDepthHandler, errHandler ErrH
 
 // levels: [10, 20, 50, 100, 1000]
 // rate: [100, 500, 100] ms, default 500ms while rate is nil
-func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (e *Environment) WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	switch levels {
 	case "10":
 	case "20":
@@ -479,14 +503,20 @@ func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			return nil, nil, errors.New("invalid rate")
 		}
 	}
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToUpper(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", e.WsURL, strings.ToUpper(symbol), levels, rateStr)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		wsDepthServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// levels: [10, 20, 50, 100, 1000]
+// rate: [100, 500, 100] ms, default 500ms while rate is nil
+func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsDepthServe(symbol, levels, rate, handler, errHandler)
+}
+
 // reference: https://binance-docs.github.io/apidocs/voptions/en/#websocket-market-streams
 //
 // streamName: you should collaborate stream names through official documentation or other function above defined,
@@ -504,16 +534,16 @@ func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 //				    map[string]interface{}{"depth": func(*WsDepthEvent) {}, "kline": func(*WsKlineEvent){}}, func(error){})
 //
 // note: the symbol(underlying) of streamName should be upper.
-func WsCombinedServe(streamName []string, handler map[string]interface{}, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (e *Environment) WsCombinedServe(streamName []string, handler map[string]interface{}, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	if len(streamName) <= 0 || len(handler) <= 0 {
 		return nil, nil, errors.New("streamName is empty or handler is empty")
 	}
-	endpoint := getCombinedEndpoint()
+	endpoint := e.CombinedWsURL
 	for _, s := range streamName {
 		endpoint += s + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := e.newWsConfig(endpoint)
 
 	// TODO: use template after go 1.8
 	tradeKey := "trade"
@@ -697,6 +727,27 @@ func WsCombinedServe(streamName []string, handler map[string]interface{}, errHan
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// reference: https://binance-docs.github.io/apidocs/voptions/en/#websocket-market-streams
+//
+// streamName: you should collaborate stream names through official documentation or other function above defined,
+//
+//	the legitimacy of parameters needs to be guaranteed by the caller
+//
+// handler: a map of handler function, its key needs to correspond to the handler of the incoming streamname,
+//
+//	handler's key should be in ["trade", "index", "markPrice", "kline", "ticker", "openInterest", "option_pair", "depth"]
+//
+// for example:
+//
+//	WsCombinedServe({"ETH-240927-5500-P@depth10"}, map[string]interface{}{"depth": func(*WsDepthEvent) {}}, func(error){})
+//	WsCombinedServe({"ETH-240927-5500-P@depth10", "ETH-240927-5500-P@kline_1m"},
+//				    map[string]interface{}{"depth": func(*WsDepthEvent) {}, "kline": func(*WsKlineEvent){}}, func(error){})
+//
+// note: the symbol(underlying) of streamName should be upper.
+func WsCombinedServe(streamName []string, handler map[string]interface{}, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsCombinedServe(streamName, handler, errHandler)
+}
+
 // WsUserDataEvent define user data event
 type WsUserDataEvent struct {
 	Event UserDataEventType `json:"e"`
@@ -774,9 +825,9 @@ type WsOrderTradeUpdate struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func (e *Environment) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", e.WsURL, listenKey)
+	cfg := e.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -788,3 +839,7 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return DefaultEnvironment().WsUserDataServe(listenKey, handler, errHandler)
+}
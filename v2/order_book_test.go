@@ -0,0 +1,76 @@
+package binance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) TestSync() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	handlers := make(chan WsHandler, 1)
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		handlers <- handler
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	s.mockDo([]byte(`{"lastUpdateId":100,"bids":[["4.00","431.00"]],"asks":[["4.20","12.00"]]}`), nil)
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest().setParam("symbol", "LTCBTC").setParam("limit", 1000), r)
+	})
+
+	book := s.client.NewOrderBook("LTCBTC", common.OrderBookConfig{})
+	s.r().NoError(book.Start(newContext()))
+	defer book.Close()
+	ctx, cancel := context.WithTimeout(newContext(), 5*time.Second)
+	defer cancel()
+	s.r().NoError(book.WaitSynced(ctx))
+	s.assertDo()
+	s.r().Equal(s.client.Environment.WsURL+"/ltcbtc@depth@100ms", endpoint)
+
+	handler := <-handlers
+	handler([]byte(`{"e":"depthUpdate","E":1,"s":"LTCBTC","U":99,"u":101,"b":[["4.10","1.00"]],"a":[["4.20","0"]]}`))
+	s.r().Eventually(func() bool { return book.LastUpdateID() == 101 }, 5*time.Second, time.Millisecond)
+	bid, ok := book.BestBid()
+	s.r().True(ok)
+	s.r().Equal("4.1", bid.Price.String())
+	_, ok = book.BestAsk()
+	s.r().False(ok)
+}
+
+func (s *orderBookTestSuite) TestNilEnvironment() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+
+	c := &Client{}
+	book := c.NewOrderBook("LTCBTC", common.OrderBookConfig{
+		Snapshot: func(ctx context.Context) (*common.DepthSnapshot, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	s.r().NoError(book.Start(ctx))
+	book.Close()
+	s.r().Equal(DefaultEnvironment().WsURL+"/ltcbtc@depth@100ms", endpoint)
+}
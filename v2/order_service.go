@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -24,6 +25,7 @@ type CreateOrderService struct {
 	trailingDelta           *string
 	icebergQuantity         *string
 	selfTradePreventionMode *SelfTradePreventionMode
+	validator               *OrderValidator
 }
 
 // Symbol set symbol
@@ -105,6 +107,9 @@ func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode Sel
 }
 
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if err = s.validate(ctx); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -226,6 +231,7 @@ type CreateOCOService struct {
 	stopIcebergQty       *string
 	stopLimitTimeInForce *TimeInForceType
 	newOrderRespType     *NewOrderRespType
+	validator            *OrderValidator
 }
 
 // Symbol set symbol
@@ -307,6 +313,9 @@ func (s *CreateOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *
 }
 
 func (s *CreateOCOService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if err = s.validate(ctx); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -428,6 +437,7 @@ func (s *ListOpenOcoService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/openOrderList",
+		weight:   6,
 		secType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -459,10 +469,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/openOrders",
+		weight:   80,
 		secType:  secTypeSigned,
 	}
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
+		r.weight = 6
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -507,6 +519,7 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/order",
+		weight:   4,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
@@ -596,6 +609,7 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/allOrders",
+		weight:   20,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
@@ -623,6 +637,35 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator iterate over the orders from OrderID, or from StartTime, until EndTime. The first order after
+// StartTime is searched by windows of 24 hours, the following ones are fetched by id.
+func (s *ListOrdersService) Iterator(opts ...RequestOption) *common.Iterator[*Order] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.IDPager[*Order]{
+		FromID:    s.orderID,
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    24 * time.Hour,
+		Limit:     limit,
+		Now:       s.c.serverTimestamp,
+		ByID: func(ctx context.Context, id int64) ([]*Order, error) {
+			p := *s
+			p.orderID, p.startTime, p.endTime, p.limit = &id, nil, nil, &limit
+			return p.Do(ctx, opts...)
+		},
+		ByTime: func(ctx context.Context, start, end int64) ([]*Order, error) {
+			p := *s
+			p.orderID, p.startTime, p.endTime, p.limit = nil, &start, &end, &limit
+			return p.Do(ctx, opts...)
+		},
+		ID:   func(o *Order) int64 { return o.OrderID },
+		Time: func(o *Order) int64 { return o.Time },
+	}.Pages())
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
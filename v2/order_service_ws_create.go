@@ -2,6 +2,7 @@ package binance
 
 import (
 	"encoding/json"
+	"sync/atomic"
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -10,21 +11,31 @@ import (
 
 // OrderCreateWsService creates order
 type OrderCreateWsService struct {
-	c          websocket.Client
-	ApiKey     string
-	SecretKey  string
-	KeyType    string
+	c         websocket.Client
+	ApiKey    string
+	SecretKey string
+	KeyType   string
+	// Signer signs the requests instead of KeyType and SecretKey when set
+	Signer common.Signer
+	// TimeOffset can be kept in sync with a client by TimeSync.Attach(&service.TimeOffset)
 	TimeOffset int64
+	// signers keep the in-process signer of KeyType and SecretKey
+	signers common.SignerCache
 }
 
 // NewOrderCreateWsService init OrderCreateWsService
 func NewOrderCreateWsService(apiKey, secretKey string) (*OrderCreateWsService, error) {
-	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	return DefaultEnvironment().NewOrderCreateWsService(apiKey, secretKey)
+}
+
+// NewOrderCreateWsService init OrderCreateWsService connected to the websocket API of the environment
+func (e *Environment) NewOrderCreateWsService(apiKey, secretKey string) (*OrderCreateWsService, error) {
+	conn, err := websocket.NewConnection(e.WsApiInitReadWriteConn, e.WsKeepalive, e.WsAPITimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := websocket.NewClient(conn)
+	client, err := websocket.NewClient(conn, websocket.WithLogger(e.Logger))
 	if err != nil {
 		return nil, err
 	}
@@ -111,16 +122,28 @@ func (s *OrderCreateWsRequest) buildParams() params {
 	return m
 }
 
+// signer return Signer when set, else the signer of KeyType and SecretKey parsed once per service
+func (s *OrderCreateWsService) signer() (common.Signer, error) {
+	if s.Signer != nil || s.SecretKey == "" || s.KeyType == "" {
+		return s.Signer, nil
+	}
+	return s.signers.Signer(s.KeyType, s.SecretKey)
+}
+
 // Do - sends 'order.place' request
 func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsRequest) error {
+	signer, err := s.signer()
+	if err != nil {
+		return err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -137,14 +160,18 @@ func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsReques
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRequest) (*CreateOrderWsResponse, error) {
+	signer, err := s.signer()
+	if err != nil {
+		return nil, err
+	}
 	rawData, err := websocket.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			atomic.LoadInt64(&s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(signer),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
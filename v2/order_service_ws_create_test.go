@@ -1,10 +1,15 @@
 package binance
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/adshao/go-binance/v2/common/websocket/mock"
 	"github.com/golang/mock/gomock"
@@ -123,6 +128,24 @@ func (s *orderPlaceServiceWsTestSuite) TestOrderPlace_EmptySignKeyType() {
 	s.Error(err)
 }
 
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlace_SignerParsedOnce() {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	s.Require().NoError(err)
+	s.reset(s.apiKey, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), common.KeyTypeEd25519, s.timeOffset)
+
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(2)
+
+	s.Require().NoError(s.orderPlace.Do(s.requestID, s.orderPlaceRequest))
+	signer, err := s.orderPlace.signer()
+	s.Require().NoError(err)
+	s.Require().NoError(s.orderPlace.Do(s.requestID, s.orderPlaceRequest))
+	next, err := s.orderPlace.signer()
+	s.Require().NoError(err)
+	s.Same(signer, next)
+}
+
 func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync() {
 	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
 
@@ -0,0 +1,319 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidator check and round the orders against the filters of a cached exchange info before
+// they are sent, the rejected orders return a *common.OrderFilterError instead of a -1013 error
+//
+//	v := binance.NewOrderValidator(info)
+//	v.Rounding = common.RoundingDown
+//	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Validate(v)...Do(ctx)
+type OrderValidator struct {
+	// Rounding round the prices and quantities to the tick and step sizes,
+	// the values which are not on a step are rejected by default
+	Rounding common.RoundingMode
+	// OpenOrders return the number of open orders of a symbol, MAX_NUM_ORDERS is checked when it is set
+	OpenOrders func(ctx context.Context, symbol string) (int, error)
+	// ReferencePrice return the average price of a symbol, e.g. from AveragePriceService.
+	// PERCENT_PRICE_BY_SIDE and the NOTIONAL of the market orders are checked when it is set.
+	ReferencePrice func(ctx context.Context, symbol string) (decimal.Decimal, error)
+
+	mu      sync.RWMutex
+	symbols map[string]*Symbol
+}
+
+// NewOrderValidator init an OrderValidator with the symbols of info
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{}
+	v.SetExchangeInfo(info)
+	return v
+}
+
+// SetExchangeInfo replace the symbols the orders are checked against
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*Symbol, len(info.Symbols))
+	for i := range info.Symbols {
+		symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.mu.Unlock()
+}
+
+// Symbol return the cached symbol, nil when it is unknown
+func (v *OrderValidator) Symbol(symbol string) *Symbol {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.symbols[symbol]
+}
+
+// orderField is a parameter of an order, it is rounded in place
+type orderField struct {
+	name  string
+	value *string
+}
+
+// orderCheck describe the orders placed by a request, two orders for an OCO
+type orderCheck struct {
+	symbol        string
+	side          SideType
+	market        bool
+	quantity      orderField
+	quoteOrderQty *string
+	// prices are the limit prices of the orders, stopPrices are only rounded to the tick size
+	prices            []orderField
+	stopPrices        []orderField
+	icebergQuantities []orderField
+	orders            int
+}
+
+// validate round the fields of the orders and check them against the filters of their symbol
+func (v *OrderValidator) validate(ctx context.Context, o orderCheck) error {
+	symbol := v.Symbol(o.symbol)
+	if symbol == nil {
+		return fmt.Errorf("symbol %s not found in the exchange info", o.symbol)
+	}
+	check := common.OrderCheck{Symbol: o.symbol, Rounding: v.Rounding}
+	if f := symbol.PriceFilter(); f != nil {
+		for _, fields := range [][]orderField{o.prices, o.stopPrices} {
+			for _, p := range fields {
+				if err := check.Step(string(SymbolFilterTypePriceFilter), p.name, p.value, f.MinPrice, f.MaxPrice, f.TickSize); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	quantities := append([]orderField{o.quantity}, o.icebergQuantities...)
+	if f := symbol.LotSizeFilter(); f != nil {
+		for _, q := range quantities {
+			if err := check.Step(string(SymbolFilterTypeLotSize), q.name, q.value, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+				return err
+			}
+		}
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil && o.market {
+		if err := check.Step(string(SymbolFilterTypeMarketLotSize), o.quantity.name, o.quantity.value, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	}
+	quantity, err := check.Decimal(o.quantity.name, o.quantity.value)
+	if err != nil {
+		return err
+	}
+	if err := v.checkIceberg(check, symbol, quantity, o.icebergQuantities); err != nil {
+		return err
+	}
+	var reference *decimal.Decimal
+	if v.ReferencePrice != nil && (o.market || symbol.PercentPriceBySideFilter() != nil) {
+		ref, err := v.ReferencePrice(ctx, o.symbol)
+		if err != nil {
+			return err
+		}
+		reference = &ref
+	}
+	if err := v.checkNotional(check, symbol, o, quantity, reference); err != nil {
+		return err
+	}
+	if f := symbol.PercentPriceBySideFilter(); f != nil && reference != nil {
+		up, down := common.ToDecimal(f.BidMultiplierUp), common.ToDecimal(f.BidMultiplierDown)
+		if o.side == SideTypeSell {
+			up, down = common.ToDecimal(f.AskMultiplierUp), common.ToDecimal(f.AskMultiplierDown)
+		}
+		for _, p := range o.prices {
+			price, err := check.Decimal(p.name, p.value)
+			if err != nil {
+				return err
+			}
+			if price == nil {
+				continue
+			}
+			if max := reference.Mul(up); up.Sign() > 0 && price.GreaterThan(max) {
+				return check.Errorf(string(SymbolFilterTypePercentPriceBySide), p.name, "%s %s is above %s, %s times the average price", p.name, price, max, up)
+			}
+			if min := reference.Mul(down); price.LessThan(min) {
+				return check.Errorf(string(SymbolFilterTypePercentPriceBySide), p.name, "%s %s is below %s, %s times the average price", p.name, price, min, down)
+			}
+		}
+	}
+	if f := symbol.MaxNumOrdersFilter(); f != nil && f.MaxNumOrders > 0 && v.OpenOrders != nil {
+		n, err := v.OpenOrders(ctx, o.symbol)
+		if err != nil {
+			return err
+		}
+		if n+o.orders > f.MaxNumOrders {
+			return check.Errorf(string(SymbolFilterTypeMaxNumOrders), "", "%d open orders, at most %d are allowed", n, f.MaxNumOrders)
+		}
+	}
+	return nil
+}
+
+// checkIceberg check the number of visible parts of the iceberg orders
+func (v *OrderValidator) checkIceberg(check common.OrderCheck, symbol *Symbol, quantity *decimal.Decimal, icebergs []orderField) error {
+	for _, q := range icebergs {
+		iceberg, err := check.Decimal(q.name, q.value)
+		if err != nil {
+			return err
+		}
+		if iceberg == nil {
+			continue
+		}
+		if !symbol.IcebergAllowed {
+			return check.Errorf(string(SymbolFilterTypeIcebergParts), q.name, "iceberg orders are not allowed")
+		}
+		f := symbol.IcebergPartsFilter()
+		if f == nil || f.Limit <= 0 || quantity == nil || iceberg.Sign() <= 0 {
+			continue
+		}
+		if parts := quantity.Div(*iceberg).Ceil(); parts.GreaterThan(decimal.NewFromInt(int64(f.Limit))) {
+			return check.Errorf(string(SymbolFilterTypeIcebergParts), q.name, "%s parts of %s, at most %d are allowed", parts, iceberg, f.Limit)
+		}
+	}
+	return nil
+}
+
+// checkNotional check the notional of the orders, the market orders use quoteOrderQty or the reference price
+func (v *OrderValidator) checkNotional(check common.OrderCheck, symbol *Symbol, o orderCheck, quantity, reference *decimal.Decimal) error {
+	f := symbol.NotionalFilter()
+	if f == nil {
+		return nil
+	}
+	min, max := common.ToDecimal(f.MinNotional), common.ToDecimal(f.MaxNotional)
+	var notionals []decimal.Decimal
+	if o.market {
+		if !f.ApplyMinToMarket {
+			min = decimal.Zero
+		}
+		if !f.ApplyMaxToMarket {
+			max = decimal.Zero
+		}
+		quote, err := check.Decimal("quoteOrderQty", o.quoteOrderQty)
+		if err != nil {
+			return err
+		}
+		switch {
+		case quote != nil:
+			notionals = append(notionals, *quote)
+		case quantity != nil && reference != nil:
+			notionals = append(notionals, quantity.Mul(*reference))
+		}
+	} else if quantity != nil {
+		for _, p := range o.prices {
+			price, err := check.Decimal(p.name, p.value)
+			if err != nil {
+				return err
+			}
+			if price != nil {
+				notionals = append(notionals, price.Mul(*quantity))
+			}
+		}
+	}
+	for _, notional := range notionals {
+		if min.Sign() > 0 && notional.LessThan(min) {
+			return check.Errorf(string(SymbolFilterTypeNotional), "quantity", "notional %s is below the minimum %s", notional, min)
+		}
+		if max.Sign() > 0 && notional.GreaterThan(max) {
+			return check.Errorf(string(SymbolFilterTypeNotional), "quantity", "notional %s is above the maximum %s", notional, max)
+		}
+	}
+	return nil
+}
+
+// isMarketOrder report whether an order type is executed at the market price
+func isMarketOrder(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeMarket, OrderTypeStopLoss, OrderTypeTakeProfit:
+		return true
+	}
+	return false
+}
+
+// Validate check and round the order with v before it is sent
+func (s *CreateOrderService) Validate(v *OrderValidator) *CreateOrderService {
+	s.validator = v
+	return s
+}
+
+func (s *CreateOrderService) validate(ctx context.Context) error {
+	if s.validator == nil {
+		return nil
+	}
+	o := orderCheck{
+		symbol:        s.symbol,
+		side:          s.side,
+		market:        isMarketOrder(s.orderType),
+		quantity:      orderField{"quantity", s.quantity},
+		quoteOrderQty: s.quoteOrderQty,
+		stopPrices:    []orderField{{"stopPrice", s.stopPrice}},
+		orders:        1,
+	}
+	if !o.market {
+		o.prices = []orderField{{"price", s.price}}
+	}
+	if s.icebergQuantity != nil {
+		o.icebergQuantities = []orderField{{"icebergQty", s.icebergQuantity}}
+	}
+	return s.validator.validate(ctx, o)
+}
+
+// Validate check and round the orders with v before they are sent
+func (s *CreateOCOService) Validate(v *OrderValidator) *CreateOCOService {
+	s.validator = v
+	return s
+}
+
+func (s *CreateOCOService) validate(ctx context.Context) error {
+	if s.validator == nil {
+		return nil
+	}
+	o := orderCheck{
+		symbol:     s.symbol,
+		side:       s.side,
+		quantity:   orderField{"quantity", s.quantity},
+		prices:     []orderField{{"price", s.price}, {"stopLimitPrice", s.stopLimitPrice}},
+		stopPrices: []orderField{{"stopPrice", s.stopPrice}},
+		orders:     2,
+	}
+	if s.limitIcebergQty != nil {
+		o.icebergQuantities = append(o.icebergQuantities, orderField{"limitIcebergQty", s.limitIcebergQty})
+	}
+	if s.stopIcebergQty != nil {
+		o.icebergQuantities = append(o.icebergQuantities, orderField{"stopIcebergQty", s.stopIcebergQty})
+	}
+	return s.validator.validate(ctx, o)
+}
+
+// Validate check and round the order with v before it is sent
+func (s *CreateMarginOrderService) Validate(v *OrderValidator) *CreateMarginOrderService {
+	s.validator = v
+	return s
+}
+
+func (s *CreateMarginOrderService) validate(ctx context.Context) error {
+	if s.validator == nil {
+		return nil
+	}
+	o := orderCheck{
+		symbol:        s.symbol,
+		side:          s.side,
+		market:        isMarketOrder(s.orderType),
+		quantity:      orderField{"quantity", s.quantity},
+		quoteOrderQty: s.quoteOrderQty,
+		stopPrices:    []orderField{{"stopPrice", s.stopPrice}},
+		orders:        1,
+	}
+	if !o.market {
+		o.prices = []orderField{{"price", s.price}}
+	}
+	if s.icebergQuantity != nil {
+		o.icebergQuantities = []orderField{{"icebergQty", s.icebergQuantity}}
+	}
+	return s.validator.validate(ctx, o)
+}
@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+	validator *OrderValidator
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func (s *orderValidationTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	info := new(ExchangeInfo)
+	s.r().NoError(json.Unmarshal([]byte(`{"symbols": [{
+		"symbol": "BTCUSDT",
+		"icebergAllowed": true,
+		"filters": [
+			{"filterType": "PRICE_FILTER", "minPrice": "0.01", "maxPrice": "1000000.00", "tickSize": "0.01"},
+			{"filterType": "LOT_SIZE", "minQty": "0.00001", "maxQty": "9000.00", "stepSize": "0.00001"},
+			{"filterType": "ICEBERG_PARTS", "limit": 10},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00", "maxQty": "100.0", "stepSize": "0.00"},
+			{"filterType": "PERCENT_PRICE_BY_SIDE", "bidMultiplierUp": "5", "bidMultiplierDown": "0.2", "askMultiplierUp": "5", "askMultiplierDown": "0.2", "avgPriceMins": 5},
+			{"filterType": "NOTIONAL", "minNotional": "5.00", "applyMinToMarket": true, "maxNotional": "9000000.00", "applyMaxToMarket": false, "avgPriceMins": 5},
+			{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200}
+		]
+	}]}`), info))
+	s.validator = NewOrderValidator(info)
+}
+
+func (s *orderValidationTestSuite) TestRoundOrder() {
+	data := []byte(`{"symbol": "BTCUSDT", "orderId": 1}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "0.12345",
+			"price":            "30000.01",
+			"icebergQty":       "0.02",
+			"newClientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	s.validator.Rounding = common.RoundingDown
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).NewClientOrderID("myOrder1").
+		Quantity("0.123456").Price("30000.019").IcebergQuantity("0.02").Validate(s.validator).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *orderValidationTestSuite) TestRejectOrder() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+	newOrder := func() *CreateOrderService {
+		return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Validate(s.validator)
+	}
+	r := s.r()
+	assertFilter := func(err error, filter string) {
+		var filterErr *common.OrderFilterError
+		r.ErrorAs(err, &filterErr)
+		r.Equal(filter, filterErr.Filter, err.Error())
+	}
+
+	_, err := newOrder().Quantity("0.1").Price("30000.019").Do(newContext())
+	assertFilter(err, "PRICE_FILTER")
+	r.NoError(newOrder().Quantity("0.001").Price("30000").validate(newContext()))
+	_, err = newOrder().Quantity("0.0001").Price("3000").Do(newContext())
+	assertFilter(err, "NOTIONAL")
+	_, err = newOrder().Quantity("1").Price("3000").IcebergQuantity("0.05").Do(newContext())
+	assertFilter(err, "ICEBERG_PARTS")
+
+	s.validator.ReferencePrice = func(ctx context.Context, symbol string) (decimal.Decimal, error) {
+		return decimal.NewFromInt(30000), nil
+	}
+	_, err = newOrder().Quantity("0.1").Price("200000").Do(newContext())
+	assertFilter(err, "PERCENT_PRICE_BY_SIDE")
+	// the notional of the market orders uses the average price
+	_, err = newOrder().Type(OrderTypeMarket).Quantity("0.0001").Do(newContext())
+	assertFilter(err, "NOTIONAL")
+	_, err = newOrder().Type(OrderTypeMarket).Quantity("101").Do(newContext())
+	assertFilter(err, "MARKET_LOT_SIZE")
+
+	s.validator.OpenOrders = func(ctx context.Context, symbol string) (int, error) {
+		return 199, nil
+	}
+	_, err = s.client.NewCreateOCOService().Symbol("BTCUSDT").Side(SideTypeSell).Quantity("0.1").
+		Price("31000").StopPrice("29000").StopLimitPrice("28900").Validate(s.validator).Do(newContext())
+	assertFilter(err, "MAX_NUM_ORDERS")
+
+	_, err = s.client.NewCreateMarginOrderService().Symbol("ETHUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).Quantity("1").Price("2000").Validate(s.validator).Do(newContext())
+	r.EqualError(err, "symbol ETHUSDT not found in the exchange info")
+}
@@ -17,6 +17,7 @@ func (s *RateLimitService) Do(ctx context.Context, opts ...RequestOption) (res [
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/rateLimit/order",
+		weight:   40,
 		secType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
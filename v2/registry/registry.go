@@ -0,0 +1,228 @@
+// Package registry keep the symbols of the spot, futures, delivery and options markets in memory,
+// refresh them periodically and report the listings, delistings, status and filter changes.
+package registry
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EventType define the type of a change of a symbol
+type EventType string
+
+// Event types
+const (
+	EventSymbolAdded    EventType = "SYMBOL_ADDED"
+	EventSymbolRemoved  EventType = "SYMBOL_REMOVED"
+	EventStatusChanged  EventType = "STATUS_CHANGED"
+	EventFiltersChanged EventType = "FILTERS_CHANGED"
+)
+
+// Event define a change of a symbol found by a refresh, Old is nil for an added symbol and New for a removed one
+type Event struct {
+	Type    EventType
+	Product Product
+	Symbol  string
+	Old     *Symbol
+	New     *Symbol
+}
+
+// Config define the products of a Registry
+type Config struct {
+	Sources []Source
+	// RefreshInterval is the period of the refreshes started by Start, 5 minutes by default
+	RefreshInterval time.Duration
+	// ErrHandler receive the errors of the periodic refreshes, the symbols of a failed source are kept
+	ErrHandler func(err error)
+}
+
+// Query select symbols, the empty fields match any value
+type Query struct {
+	Product      Product
+	BaseAsset    string
+	QuoteAsset   string
+	ContractType string
+	Status       string
+}
+
+// match report whether s is selected by q
+func (q Query) match(s *Symbol) bool {
+	return (q.Product == "" || q.Product == s.Product) &&
+		(q.BaseAsset == "" || q.BaseAsset == s.BaseAsset) &&
+		(q.QuoteAsset == "" || q.QuoteAsset == s.QuoteAsset) &&
+		(q.ContractType == "" || q.ContractType == s.ContractType) &&
+		(q.Status == "" || q.Status == s.Status)
+}
+
+// Registry index the symbols of the products and report their changes
+type Registry struct {
+	cfg Config
+	// refreshMu serialize the refreshes, so that the events follow each other
+	refreshMu sync.Mutex
+	mu        sync.RWMutex
+	symbols   map[Product]map[string]*Symbol
+	handlers  map[int]func(Event)
+	nextID    int
+}
+
+// New init an empty Registry, see Start and Refresh
+func New(cfg Config) *Registry {
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = 5 * time.Minute
+	}
+	return &Registry{
+		cfg:      cfg,
+		symbols:  map[Product]map[string]*Symbol{},
+		handlers: map[int]func(Event){},
+	}
+}
+
+// Start load the symbols, then refresh them in the background until ctx is done
+func (r *Registry) Start(ctx context.Context) error {
+	if err := r.Refresh(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(r.cfg.RefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil && ctx.Err() == nil && r.cfg.ErrHandler != nil {
+					r.cfg.ErrHandler(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Refresh fetch the symbols of every source and call the subscribers with the changes.
+// The first load of a product emits no event. The first error is returned once all the
+// sources were fetched, the symbols of the failed sources are kept.
+func (r *Registry) Refresh(ctx context.Context) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	var firstErr error
+	for _, src := range r.cfg.Sources {
+		symbols, err := src.Fetch(ctx)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		index := make(map[string]*Symbol, len(symbols))
+		for i := range symbols {
+			index[symbols[i].Symbol] = &symbols[i]
+		}
+		r.mu.Lock()
+		old, loaded := r.symbols[src.Product]
+		r.symbols[src.Product] = index
+		r.mu.Unlock()
+		if loaded {
+			for _, e := range diff(src.Product, old, index) {
+				r.emit(e)
+			}
+		}
+	}
+	return firstErr
+}
+
+// diff return the changes from old to symbols, ordered by symbol, the symbols of the events are copies
+func diff(product Product, old, symbols map[string]*Symbol) []Event {
+	var events []Event
+	for name, s := range symbols {
+		prev, ok := old[name]
+		if !ok {
+			events = append(events, Event{Type: EventSymbolAdded, Product: product, Symbol: name, New: s.clone()})
+			continue
+		}
+		// a symbol may change its status and its filters in the same refresh
+		if prev.Status != s.Status {
+			events = append(events, Event{Type: EventStatusChanged, Product: product, Symbol: name, Old: prev.clone(), New: s.clone()})
+		}
+		if !reflect.DeepEqual(prev.Filters, s.Filters) {
+			events = append(events, Event{Type: EventFiltersChanged, Product: product, Symbol: name, Old: prev.clone(), New: s.clone()})
+		}
+	}
+	for name, prev := range old {
+		if _, ok := symbols[name]; !ok {
+			events = append(events, Event{Type: EventSymbolRemoved, Product: product, Symbol: name, Old: prev.clone()})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Symbol < events[j].Symbol
+	})
+	return events
+}
+
+// emit call the subscribers with e
+func (r *Registry) emit(e Event) {
+	r.mu.RLock()
+	ids := make([]int, 0, len(r.handlers))
+	for id := range r.handlers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	handlers := make([]func(Event), len(ids))
+	for i, id := range ids {
+		handlers[i] = r.handlers[id]
+	}
+	r.mu.RUnlock()
+	for _, handler := range handlers {
+		handler(e)
+	}
+}
+
+// Subscribe call handler with the changes found by the refreshes, in the refreshing goroutine.
+// The returned function removes the subscription.
+func (r *Registry) Subscribe(handler func(Event)) (unsubscribe func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := r.nextID
+	r.nextID++
+	r.handlers[id] = handler
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.handlers, id)
+	}
+}
+
+// Symbol return a copy of a symbol of a product, ok is false when it is unknown
+func (r *Registry) Symbol(product Product, symbol string) (s Symbol, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	found, ok := r.symbols[product][symbol]
+	if !ok {
+		return Symbol{}, false
+	}
+	return *found.clone(), true
+}
+
+// Symbols return copies of the symbols selected by q, ordered by product and symbol
+func (r *Registry) Symbols(q Query) []Symbol {
+	r.mu.RLock()
+	var res []Symbol
+	for _, symbols := range r.symbols {
+		for _, s := range symbols {
+			if q.match(s) {
+				res = append(res, *s.clone())
+			}
+		}
+	}
+	r.mu.RUnlock()
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Product != res[j].Product {
+			return res[i].Product < res[j].Product
+		}
+		return res[i].Symbol < res[j].Symbol
+	})
+	return res
+}
@@ -0,0 +1,194 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/binancetest"
+)
+
+// fakeSource serve the symbols it holds
+type fakeSource struct {
+	mu      sync.Mutex
+	symbols []Symbol
+	err     error
+}
+
+func (f *fakeSource) set(symbols ...Symbol) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.symbols = symbols
+}
+
+func (f *fakeSource) source(product Product) Source {
+	return Source{
+		Product: product,
+		Fetch: func(ctx context.Context) ([]Symbol, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			if f.err != nil {
+				return nil, f.err
+			}
+			return append([]Symbol(nil), f.symbols...), nil
+		},
+	}
+}
+
+func spotSymbol(symbol, base, status, tickSize string) Symbol {
+	return Symbol{
+		Product:    ProductSpot,
+		Symbol:     symbol,
+		BaseAsset:  base,
+		QuoteAsset: "USDT",
+		Status:     status,
+		Filters:    []map[string]interface{}{{"filterType": "PRICE_FILTER", "tickSize": tickSize}},
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	spot, futures := &fakeSource{}, &fakeSource{}
+	spot.set(spotSymbol("BTCUSDT", "BTC", "TRADING", "0.01"), spotSymbol("ETHUSDT", "ETH", "TRADING", "0.01"))
+	futures.set(Symbol{Product: ProductFutures, Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", ContractType: "PERPETUAL", Status: "TRADING"})
+	r := New(Config{Sources: []Source{spot.source(ProductSpot), futures.source(ProductFutures)}})
+	var events []Event
+	unsubscribe := r.Subscribe(func(e Event) {
+		events = append(events, e)
+	})
+	ctx := context.Background()
+
+	// the first load emits no event
+	require.NoError(t, r.Refresh(ctx))
+	assert.Empty(t, events)
+	s, ok := r.Symbol(ProductSpot, "ETHUSDT")
+	assert.True(t, ok)
+	assert.Equal(t, "ETH", s.BaseAsset)
+	_, ok = r.Symbol(ProductDelivery, "ETHUSDT")
+	assert.False(t, ok)
+	btc := r.Symbols(Query{BaseAsset: "BTC"})
+	require.Len(t, btc, 2)
+	assert.Equal(t, ProductFutures, btc[0].Product)
+	assert.Equal(t, ProductSpot, btc[1].Product)
+	assert.Len(t, r.Symbols(Query{ContractType: "PERPETUAL"}), 1)
+
+	spot.set(
+		spotSymbol("BTCUSDT", "BTC", "BREAK", "0.01"),
+		spotSymbol("ETHUSDT", "ETH", "TRADING", "0.1"),
+		spotSymbol("SOLUSDT", "SOL", "TRADING", "0.01"),
+	)
+	futures.set()
+	require.NoError(t, r.Refresh(ctx))
+	types := func() []EventType {
+		res := make([]EventType, len(events))
+		for i, e := range events {
+			res[i] = e.Type
+		}
+		return res
+	}
+	assert.Equal(t, []EventType{EventStatusChanged, EventFiltersChanged, EventSymbolAdded, EventSymbolRemoved}, types())
+	assert.Equal(t, "TRADING", events[0].Old.Status)
+	assert.Equal(t, "BREAK", events[0].New.Status)
+	assert.Equal(t, "SOLUSDT", events[2].Symbol)
+	assert.Nil(t, events[2].Old)
+	assert.Equal(t, ProductFutures, events[3].Product)
+	assert.Nil(t, events[3].New)
+	assert.Len(t, r.Symbols(Query{Status: "TRADING"}), 2)
+
+	// the symbols of a failed source are kept
+	events = nil
+	spot.err = errors.New("failed")
+	futures.set(Symbol{Product: ProductFutures, Symbol: "ETHUSDT"})
+	assert.EqualError(t, r.Refresh(ctx), "failed")
+	assert.Len(t, r.Symbols(Query{Product: ProductSpot}), 3)
+	assert.Equal(t, []EventType{EventSymbolAdded}, types())
+
+	unsubscribe()
+	events = nil
+	spot.err = nil
+	futures.set()
+	require.NoError(t, r.Refresh(ctx))
+	assert.Empty(t, events)
+}
+
+func TestRegistryStatusAndFilters(t *testing.T) {
+	src := &fakeSource{}
+	src.set(spotSymbol("BTCUSDT", "BTC", "TRADING", "0.01"))
+	r := New(Config{Sources: []Source{src.source(ProductSpot)}})
+	var events []Event
+	r.Subscribe(func(e Event) {
+		events = append(events, e)
+	})
+	ctx := context.Background()
+	require.NoError(t, r.Refresh(ctx))
+
+	// the callers can not change the symbols of the registry
+	s, ok := r.Symbol(ProductSpot, "BTCUSDT")
+	require.True(t, ok)
+	s.Filters[0]["tickSize"] = "1"
+	r.Symbols(Query{})[0].Filters[0]["tickSize"] = "1"
+	s, _ = r.Symbol(ProductSpot, "BTCUSDT")
+	assert.Equal(t, "0.01", s.Filters[0]["tickSize"])
+
+	src.set(spotSymbol("BTCUSDT", "BTC", "BREAK", "0.1"))
+	require.NoError(t, r.Refresh(ctx))
+	require.Len(t, events, 2)
+	assert.Equal(t, EventStatusChanged, events[0].Type)
+	assert.Equal(t, EventFiltersChanged, events[1].Type)
+	assert.Equal(t, "0.01", events[1].Old.Filters[0]["tickSize"])
+	assert.Equal(t, "0.1", events[1].New.Filters[0]["tickSize"])
+	events[1].New.Filters[0]["tickSize"] = "1"
+	s, _ = r.Symbol(ProductSpot, "BTCUSDT")
+	assert.Equal(t, "0.1", s.Filters[0]["tickSize"])
+}
+
+func TestRegistryStart(t *testing.T) {
+	src := &fakeSource{}
+	src.set(spotSymbol("BTCUSDT", "BTC", "TRADING", "0.01"))
+	errs := make(chan error, 1)
+	r := New(Config{
+		Sources:         []Source{src.source(ProductSpot)},
+		RefreshInterval: 10 * time.Millisecond,
+		ErrHandler:      func(err error) { errs <- err },
+	})
+	added := make(chan Event, 1)
+	r.Subscribe(func(e Event) { added <- e })
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+	src.set(spotSymbol("BTCUSDT", "BTC", "TRADING", "0.01"), spotSymbol("ETHUSDT", "ETH", "TRADING", "0.01"))
+	select {
+	case e := <-added:
+		assert.Equal(t, "ETHUSDT", e.Symbol)
+	case <-time.After(time.Second):
+		t.Fatal("no refresh")
+	}
+	src.mu.Lock()
+	src.err = errors.New("failed")
+	src.mu.Unlock()
+	select {
+	case err := <-errs:
+		assert.EqualError(t, err, "failed")
+	case <-time.After(time.Second):
+		t.Fatal("no error")
+	}
+}
+
+func TestSources(t *testing.T) {
+	spot := binancetest.NewServer(binancetest.Config{})
+	defer spot.Close()
+	futures := binancetest.NewFuturesServer(binancetest.FuturesConfig{})
+	defer futures.Close()
+	r := New(Config{Sources: []Source{Spot(spot.NewClient()), Futures(futures.NewClient())}})
+	require.NoError(t, r.Refresh(context.Background()))
+	symbols := r.Symbols(Query{BaseAsset: "BTC", QuoteAsset: "USDT"})
+	require.Len(t, symbols, 2)
+	assert.Equal(t, ProductFutures, symbols[0].Product)
+	assert.Equal(t, "PERPETUAL", symbols[0].ContractType)
+	assert.Equal(t, "TRADING", symbols[1].Status)
+	assert.NotEmpty(t, symbols[1].Filters)
+}
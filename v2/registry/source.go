@@ -0,0 +1,196 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// Product define a market of the exchange
+type Product string
+
+// Products of the exchange
+const (
+	ProductSpot     Product = "SPOT"
+	ProductFutures  Product = "FUTURES"
+	ProductDelivery Product = "DELIVERY"
+	ProductOptions  Product = "OPTIONS"
+)
+
+// Symbol define a symbol of any product
+type Symbol struct {
+	Product    Product
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+	// ContractType is the contract type of the futures, e.g. PERPETUAL, and the side of the options, CALL or PUT
+	ContractType string
+	// Status is the trading status, e.g. TRADING or BREAK, the options have none
+	Status  string
+	Filters []map[string]interface{}
+	// Info is the symbol of the product package: *binance.Symbol, *futures.Symbol, *delivery.Symbol
+	// or *options.OptionSymbol
+	Info interface{}
+}
+
+// clone return a copy of s whose filters do not share memory with s, Info is shared
+func (s *Symbol) clone() *Symbol {
+	c := *s
+	if s.Filters != nil {
+		c.Filters = make([]map[string]interface{}, len(s.Filters))
+		for i, f := range s.Filters {
+			c.Filters[i], _ = cloneValue(f).(map[string]interface{})
+		}
+	}
+	return &c
+}
+
+// cloneValue return a deep copy of a decoded JSON value
+func cloneValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		c := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			c[k] = cloneValue(e)
+		}
+		return c
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		c := make([]interface{}, len(v))
+		for i, e := range v {
+			c[i] = cloneValue(e)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// Source define the exchange info endpoint of a product
+type Source struct {
+	Product Product
+	// Fetch return all the symbols of the product
+	Fetch func(ctx context.Context) ([]Symbol, error)
+}
+
+// Spot return the source of the spot symbols
+func Spot(c *binance.Client) Source {
+	return Source{
+		Product: ProductSpot,
+		Fetch: func(ctx context.Context) ([]Symbol, error) {
+			info, err := c.NewExchangeInfoService().Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]Symbol, len(info.Symbols))
+			for i := range info.Symbols {
+				s := &info.Symbols[i]
+				res[i] = Symbol{
+					Product:    ProductSpot,
+					Symbol:     s.Symbol,
+					BaseAsset:  s.BaseAsset,
+					QuoteAsset: s.QuoteAsset,
+					Status:     s.Status,
+					Filters:    s.Filters,
+					Info:       s,
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+// Futures return the source of the USD-M futures symbols
+func Futures(c *futures.Client) Source {
+	return Source{
+		Product: ProductFutures,
+		Fetch: func(ctx context.Context) ([]Symbol, error) {
+			info, err := c.NewExchangeInfoService().Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]Symbol, len(info.Symbols))
+			for i := range info.Symbols {
+				s := &info.Symbols[i]
+				res[i] = Symbol{
+					Product:      ProductFutures,
+					Symbol:       s.Symbol,
+					BaseAsset:    s.BaseAsset,
+					QuoteAsset:   s.QuoteAsset,
+					ContractType: string(s.ContractType),
+					Status:       s.Status,
+					Filters:      s.Filters,
+					Info:         s,
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+// Delivery return the source of the COIN-M futures symbols
+func Delivery(c *delivery.Client) Source {
+	return Source{
+		Product: ProductDelivery,
+		Fetch: func(ctx context.Context) ([]Symbol, error) {
+			info, err := c.NewExchangeInfoService().Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res := make([]Symbol, len(info.Symbols))
+			for i := range info.Symbols {
+				s := &info.Symbols[i]
+				res[i] = Symbol{
+					Product:      ProductDelivery,
+					Symbol:       s.Symbol,
+					BaseAsset:    s.BaseAsset,
+					QuoteAsset:   s.QuoteAsset,
+					ContractType: s.ContractType,
+					Status:       s.ContractStatus,
+					Filters:      s.Filters,
+					Info:         s,
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+// Options return the source of the options symbols, their base asset is the one of their contract
+func Options(c *options.Client) Source {
+	return Source{
+		Product: ProductOptions,
+		Fetch: func(ctx context.Context) ([]Symbol, error) {
+			info, err := c.NewExchangeInfoService().Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			contracts := make(map[int64]options.OptionContract, len(info.OptionContracts))
+			for _, contract := range info.OptionContracts {
+				contracts[contract.Id] = contract
+			}
+			res := make([]Symbol, len(info.OptionSymbols))
+			for i := range info.OptionSymbols {
+				s := &info.OptionSymbols[i]
+				res[i] = Symbol{
+					Product:      ProductOptions,
+					Symbol:       s.Symbol,
+					BaseAsset:    contracts[s.ContractId].BaseAsset,
+					QuoteAsset:   s.QuoteAsset,
+					ContractType: s.Side,
+					Filters:      s.Filters,
+					Info:         s,
+				}
+			}
+			return res, nil
+		},
+	}
+}
@@ -1,11 +1,18 @@
 package binance
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -77,6 +84,24 @@ func (s *serverServiceTestSuite) TestServerTimeBadRequest() {
 	s.r().True(common.IsAPIError(err))
 }
 
+func (s *serverServiceTestSuite) TestServerTimeBadRequestErrorCode() {
+	s.mockDo([]byte(`{
+        "code": -1121,
+        "msg": "Invalid symbol."
+    }`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().ErrorIs(err, common.ErrBadSymbol)
+	apiErr := common.AsAPIError(err)
+	s.r().NotNil(apiErr)
+	s.r().Equal(http.StatusBadRequest, apiErr.StatusCode)
+}
+
 func (s *serverServiceTestSuite) TestInvalidResponseBody() {
 	s.mockDo([]byte(``), nil)
 	defer s.assertDo()
@@ -105,3 +130,86 @@ func (s *serverServiceTestSuite) TestSetServerTime() {
 	s.r().NotZero(s.client.TimeOffset)
 	s.r().EqualValues(timeOffset, s.client.TimeOffset)
 }
+
+func TestClientTimeSync(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	serverTime := currentTimestamp() - 5000
+	var timestamps []int64
+	c.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v3/time" {
+			return newHTTPResponse([]byte(fmt.Sprintf(`{"serverTime": %d}`, serverTime)), http.StatusOK), nil
+		}
+		ts, _ := strconv.ParseInt(req.URL.Query().Get(timestampKey), 10, 64)
+		timestamps = append(timestamps, ts)
+		if ts > serverTime+1000 {
+			return newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request was 1000ms ahead of the server's time."}`), http.StatusBadRequest), nil
+		}
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+	policy := common.NewBackoffRetryPolicy()
+	policy.MinDelay = time.Millisecond
+	c.RetryPolicy = policy
+	c.TimeSync = common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	}, 0, &c.TimeOffset)
+
+	// the -1021 error triggers a resync and the retry is signed with the new offset
+	_, err := c.NewGetAccountService().Do(newContext())
+	require.NoError(t, err)
+	require.Len(t, timestamps, 2)
+	assert.InDelta(t, 5000, c.TimeOffset, 1000)
+	assert.InDelta(t, serverTime, timestamps[1], 1000)
+
+	ts := c.StartTimeSync(time.Hour)
+	defer ts.Stop()
+	assert.Equal(t, ts, c.TimeSync)
+}
+
+func TestClientStartTimeSyncConcurrentRequests(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v3/time" {
+			return newHTTPResponse([]byte(fmt.Sprintf(`{"serverTime": %d}`, currentTimestamp())), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), http.StatusBadRequest), nil
+	}
+
+	// run with -race: requests read the TimeSync while StartTimeSync replaces it
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.NewGetAccountService().Do(newContext())
+			assert.Error(t, err)
+		}()
+	}
+	ts := c.StartTimeSync(time.Hour)
+	wg.Wait()
+	ts.Stop()
+	assert.Equal(t, ts, c.timeSync())
+}
+
+func TestClientStartTimeSyncTwice(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	var requests int64
+	c.do = func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt64(&requests, 1)
+		return newHTTPResponse([]byte(fmt.Sprintf(`{"serverTime": %d}`, currentTimestamp())), http.StatusOK), nil
+	}
+
+	first := c.StartTimeSync(time.Hour)
+	second := c.StartTimeSync(time.Hour)
+	defer second.Stop()
+	assert.Equal(t, second, c.timeSync())
+	require.Eventually(t, func() bool { return !second.LastSync().IsZero() }, time.Second, time.Millisecond)
+
+	// only the second sampler is running, triggering the first one does not sync
+	count := atomic.LoadInt64(&requests)
+	first.Trigger()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, count, atomic.LoadInt64(&requests))
+
+	second.Trigger()
+	assert.Eventually(t, func() bool { return atomic.LoadInt64(&requests) > count }, time.Second, time.Millisecond)
+}
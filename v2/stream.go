@@ -0,0 +1,31 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Subscribe open a stream with serve on a copy of e, DefaultEnvironment() when nil, and deliver its messages,
+// errors and reconnections on a common.Stream. The stream is stopped when ctx is done, e.g.
+//
+//	stream, err := binance.Subscribe(ctx, env, func(e *binance.Environment, handler func(*binance.WsDepthEvent), errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return e.WsDepthServe("BNBBTC", handler, errHandler)
+//	})
+//	for {
+//		event, err := stream.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(event.Bids)
+//	}
+func Subscribe[T any](ctx context.Context, e *Environment, serve func(e *Environment, handler func(event T), errHandler ErrHandler) (doneC, stopC chan struct{}, err error)) (*common.Stream[T], error) {
+	if e == nil {
+		e = DefaultEnvironment()
+	}
+	return common.NewStream(ctx, e.WsReconnect, func(handler func(event T), errHandler func(err error), reconnect *common.WsReconnect) (doneC, stopC chan struct{}, err error) {
+		env := *e
+		env.WsReconnect = reconnect
+		return serve(&env, handler, errHandler)
+	})
+}
@@ -0,0 +1,121 @@
+package binance
+
+import (
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamManager subscribe the market streams of many symbols on a few shared connections,
+// the subscriptions change without reconnecting. The streams are named like on the exchange,
+// e.g. btcusdt@depth or btcusdt@kline_1m, see common.StreamMux.
+type StreamManager struct {
+	mux        *common.StreamMux
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined streams of the environment, errHandler receive
+// the errors of the connections and of the parsing of the messages.
+// The lost connections are reconnected with WsReconnect when it is set.
+func (e *Environment) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	cfg := common.StreamMuxConfig{
+		URL:               strings.TrimSuffix(e.CombinedWsURL, "?streams="),
+		MaxStreams:        1024,
+		MessagesPerSecond: 5,
+		Reconnect:         e.WsReconnect,
+		ErrHandler:        errHandler,
+	}
+	if e.ProxyURL != "" {
+		proxy := e.ProxyURL
+		cfg.Proxy = &proxy
+	}
+	return &StreamManager{mux: common.NewStreamMux(cfg), errHandler: errHandler}
+}
+
+// NewStreamManager init a StreamManager on the default environment
+func NewStreamManager(errHandler ErrHandler) *StreamManager {
+	return DefaultEnvironment().NewStreamManager(errHandler)
+}
+
+// streamNames return the streams of symbols with a suffix, e.g. @depth
+func streamNames(symbols []string, suffix string) []string {
+	streams := make([]string, len(symbols))
+	for i, s := range symbols {
+		streams[i] = strings.ToLower(s) + suffix
+	}
+	return streams
+}
+
+// Subscribe call handler with the raw data of streams
+func (m *StreamManager) Subscribe(handler common.StreamHandler, streams ...string) error {
+	return m.mux.Subscribe(handler, streams...)
+}
+
+// SubscribeDepth subscribe the diff. depth streams of symbols, <symbol>@depth
+func (m *StreamManager) SubscribeDepth(handler WsDepthHandler, symbols ...string) error {
+	return m.mux.Subscribe(m.depthHandler(handler), streamNames(symbols, "@depth")...)
+}
+
+// SubscribeDepth100Ms subscribe the diff. depth streams of symbols updated every 100ms, <symbol>@depth@100ms
+func (m *StreamManager) SubscribeDepth100Ms(handler WsDepthHandler, symbols ...string) error {
+	return m.mux.Subscribe(m.depthHandler(handler), streamNames(symbols, "@depth@100ms")...)
+}
+
+func (m *StreamManager) depthHandler(handler WsDepthHandler) common.StreamHandler {
+	return func(stream string, data []byte) {
+		j, err := newJSON(data)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(newWsDepthEvent(j))
+	}
+}
+
+// SubscribeKline subscribe the kline streams of symbols with an interval like 15m, <symbol>@kline_<interval>
+func (m *StreamManager) SubscribeKline(handler WsKlineHandler, interval string, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@kline_"+interval)...)
+}
+
+// SubscribeTrade subscribe the trade streams of symbols, <symbol>@trade
+func (m *StreamManager) SubscribeTrade(handler WsTradeHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@trade")...)
+}
+
+// SubscribeAggTrade subscribe the aggregate trade streams of symbols, <symbol>@aggTrade
+func (m *StreamManager) SubscribeAggTrade(handler WsAggTradeHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@aggTrade")...)
+}
+
+// SubscribeBookTicker subscribe the best bid and ask streams of symbols, <symbol>@bookTicker
+func (m *StreamManager) SubscribeBookTicker(handler WsBookTickerHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@bookTicker")...)
+}
+
+// SubscribeTicker subscribe the 24hr statistics streams of symbols, <symbol>@ticker
+func (m *StreamManager) SubscribeTicker(handler WsMarketStatHandler, symbols ...string) error {
+	return m.mux.Subscribe(common.JSONStreamHandler(handler, m.errHandler), streamNames(symbols, "@ticker")...)
+}
+
+// Unsubscribe stop streams, e.g. btcusdt@depth
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	return m.mux.Unsubscribe(streams...)
+}
+
+// ListSubscriptions return the streams subscribed according to the server
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	return m.mux.ListSubscriptions()
+}
+
+// Streams return the subscribed streams
+func (m *StreamManager) Streams() []string {
+	return m.mux.Streams()
+}
+
+// Close close the connections
+func (m *StreamManager) Close() {
+	m.mux.Close()
+}
@@ -0,0 +1,96 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStreamServer serve combined streams, every subscribed stream receives the message of its type once
+func newStreamServer(t *testing.T, messages map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			var req struct {
+				Method string   `json:"method"`
+				Params []string `json:"params"`
+				ID     int64    `json:"id"`
+			}
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			assert.Equal(t, "/stream", r.URL.Path)
+			c.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID})
+			for _, stream := range req.Params {
+				data := messages[stream[strings.Index(stream, "@"):]]
+				c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"`+stream+`","data":`+data+`}`))
+			}
+		}
+	}))
+}
+
+func TestStreamManager(t *testing.T) {
+	server := newStreamServer(t, map[string]string{
+		"@depth":       `{"e":"depthUpdate","E":1,"s":"BNBBTC","U":157,"u":160,"b":[["0.0024","10"]],"a":[["0.0026","100"]]}`,
+		"@kline_1m":    `{"e":"kline","E":1,"s":"BNBBTC","k":{"t":123400000,"i":"1m","c":"0.0020"}}`,
+		"@trade":       `{"e":"trade","E":1,"s":"BNBBTC","t":12345,"p":"0.001"}`,
+		"@aggTrade":    `"invalid"`,
+		"@bookTicker":  `{"u":400900217,"s":"BNBUSDT","b":"25.35190000"}`,
+		"@ticker":      `{"e":"24hrTicker","s":"BNBBTC","c":"0.0025"}`,
+		"@depth@100ms": `{"e":"depthUpdate","s":"ETHBTC","u":2}`,
+	})
+	defer server.Close()
+	env := MainnetEnvironment()
+	env.CombinedWsURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/stream?streams="
+	errs := make(chan error, 1)
+	m := env.NewStreamManager(func(err error) { errs <- err })
+	defer m.Close()
+
+	events := make(chan interface{}, 1)
+	next := func() interface{} {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event")
+			return nil
+		}
+	}
+	require.NoError(t, m.SubscribeDepth(func(event *WsDepthEvent) { events <- event }, "BNBBTC"))
+	depth := next().(*WsDepthEvent)
+	assert.Equal(t, int64(160), depth.LastUpdateID)
+	assert.Equal(t, []Bid{{Price: "0.0024", Quantity: "10"}}, depth.Bids)
+	assert.Equal(t, []Ask{{Price: "0.0026", Quantity: "100"}}, depth.Asks)
+
+	require.NoError(t, m.SubscribeDepth100Ms(func(event *WsDepthEvent) { events <- event }, "ETHBTC"))
+	assert.Equal(t, "ETHBTC", next().(*WsDepthEvent).Symbol)
+	require.NoError(t, m.SubscribeKline(func(event *WsKlineEvent) { events <- event }, "1m", "BNBBTC"))
+	assert.Equal(t, "0.0020", next().(*WsKlineEvent).Kline.Close)
+	require.NoError(t, m.SubscribeTrade(func(event *WsTradeEvent) { events <- event }, "BNBBTC"))
+	assert.Equal(t, int64(12345), next().(*WsTradeEvent).TradeID)
+	require.NoError(t, m.SubscribeBookTicker(func(event *WsBookTickerEvent) { events <- event }, "BNBUSDT"))
+	assert.Equal(t, "25.35190000", next().(*WsBookTickerEvent).BestBidPrice)
+	require.NoError(t, m.SubscribeTicker(func(event *WsMarketStatEvent) { events <- event }, "BNBBTC"))
+	assert.Equal(t, "0.0025", next().(*WsMarketStatEvent).LastPrice)
+
+	require.NoError(t, m.SubscribeAggTrade(func(event *WsAggTradeEvent) { events <- event }, "BNBBTC"))
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no error")
+	}
+
+	require.NoError(t, m.Unsubscribe("bnbbtc@trade", "bnbbtc@depth"))
+	assert.Equal(t, []string{"bnbbtc@aggTrade", "bnbbtc@kline_1m", "bnbbtc@ticker", "bnbusdt@bookTicker", "ethbtc@depth@100ms"}, m.Streams())
+}
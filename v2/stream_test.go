@@ -0,0 +1,54 @@
+package binance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestSubscribe(t *testing.T) {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var cfg *WsConfig
+	stopped := make(chan struct{})
+	wsServe = func(c *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		cfg = c
+		doneC, stopC = make(chan struct{}), make(chan struct{})
+		go func() {
+			handler([]byte(`{"e":"trade","s":"BNBBTC","t":12345}`))
+			<-stopC
+			close(stopped)
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+	env := MainnetEnvironment()
+	env.WsReconnect = &common.WsReconnect{MaxAttempts: 3}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := Subscribe(ctx, env, func(e *Environment, handler func(*WsTradeEvent), errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return e.WsTradeServe("BNBBTC", handler, errHandler)
+	})
+	require.NoError(t, err)
+	// the reconnection of the stream reports to the stream, the environment is unchanged
+	require.NotNil(t, cfg.Reconnect)
+	assert.Equal(t, 3, cfg.Reconnect.MaxAttempts)
+	assert.NotNil(t, cfg.Reconnect.OnGap)
+	assert.Nil(t, env.WsReconnect.OnGap)
+
+	nextCtx, nextCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer nextCancel()
+	event, err := stream.Next(nextCtx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), event.TradeID)
+
+	cancel()
+	<-stopped
+	_, err = stream.Next(nextCtx)
+	assert.Equal(t, context.Canceled, err)
+}
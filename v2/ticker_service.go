@@ -25,9 +25,11 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/ticker/bookTicker",
+		weight:   4,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 2
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	data = common.ToJSONList(data)
@@ -69,9 +71,11 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/ticker/price",
+		weight:   4,
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 2
 	} else if s.symbols != nil {
 		s, _ := json.Marshal(s.symbols)
 		r.setParam("symbols", string(s))
@@ -125,12 +129,20 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/ticker/24hr",
+		weight:   80,
 	}
 
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+		r.weight = 2
 	} else if s.symbols != nil {
 		r.setParam("symbols", s.symbols)
+		switch {
+		case len(s.symbols) <= 20:
+			r.weight = 2
+		case len(s.symbols) <= 100:
+			r.weight = 40
+		}
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -188,6 +200,7 @@ func (s *AveragePriceService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/avgPrice",
+		weight:   2,
 	}
 	r.setParam("symbol", s.symbol)
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -265,6 +278,7 @@ func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/ticker",
+		weight:   symbolsWeight(s.symbol, s.symbols),
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
@@ -289,3 +303,14 @@ func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// symbolsWeight return the weight of the rolling window tickers, 4 per symbol up to 200
+func symbolsWeight(symbol *string, symbols []string) int64 {
+	if symbol != nil {
+		return 4
+	}
+	if weight := 4 * int64(len(symbols)); weight < 200 {
+		return weight
+	}
+	return 200
+}
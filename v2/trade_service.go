@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -58,6 +61,7 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/myTrades",
+		weight:   20,
 		secType:  secTypeSigned,
 	}
 	r.setParam("symbol", s.symbol)
@@ -75,6 +79,7 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	}
 	if s.orderId != nil {
 		r.setParam("orderId", *s.orderId)
+		r.weight = 5
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -88,6 +93,35 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator iterate over the trades from FromID, or from StartTime, until EndTime. The first trade after
+// StartTime is searched by windows of 24 hours, the following ones are fetched by id.
+func (s *ListTradesService) Iterator(opts ...RequestOption) *common.Iterator[*TradeV3] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.IDPager[*TradeV3]{
+		FromID:    s.fromID,
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    24 * time.Hour,
+		Limit:     limit,
+		Now:       s.c.serverTimestamp,
+		ByID: func(ctx context.Context, id int64) ([]*TradeV3, error) {
+			p := *s
+			p.fromID, p.startTime, p.endTime, p.limit = &id, nil, nil, &limit
+			return p.Do(ctx, opts...)
+		},
+		ByTime: func(ctx context.Context, start, end int64) ([]*TradeV3, error) {
+			p := *s
+			p.fromID, p.startTime, p.endTime, p.limit = nil, &start, &end, &limit
+			return p.Do(ctx, opts...)
+		},
+		ID:   func(t *TradeV3) int64 { return t.ID },
+		Time: func(t *TradeV3) int64 { return t.Time },
+	}.Pages())
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -119,6 +153,7 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/historicalTrades",
+		weight:   25,
 		secType:  secTypeAPIKey,
 	}
 	r.setParam("symbol", s.symbol)
@@ -216,6 +251,7 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/aggTrades",
+		weight:   4,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.fromID != nil {
@@ -242,6 +278,35 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// Iterator iterate over the aggregate trades from FromID, or from StartTime, until EndTime. The first trade
+// after StartTime is searched by windows of 1 hour, the following ones are fetched by id.
+func (s *AggTradesService) Iterator(opts ...RequestOption) *common.Iterator[*AggTrade] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.IDPager[*AggTrade]{
+		FromID:    s.fromID,
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    time.Hour,
+		Limit:     limit,
+		Now:       s.c.serverTimestamp,
+		ByID: func(ctx context.Context, id int64) ([]*AggTrade, error) {
+			p := *s
+			p.fromID, p.startTime, p.endTime, p.limit = &id, nil, nil, &limit
+			return p.Do(ctx, opts...)
+		},
+		ByTime: func(ctx context.Context, start, end int64) ([]*AggTrade, error) {
+			p := *s
+			p.fromID, p.startTime, p.endTime, p.limit = nil, &start, &end, &limit
+			return p.Do(ctx, opts...)
+		},
+		ID:   func(t *AggTrade) int64 { return t.AggTradeID },
+		Time: func(t *AggTrade) int64 { return t.Timestamp },
+	}.Pages())
+}
+
 // AggTrade define aggregate trade info
 type AggTrade struct {
 	AggTradeID       int64  `json:"a"`
@@ -278,6 +343,7 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v1/trades",
+		weight:   25,
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
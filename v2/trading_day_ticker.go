@@ -59,6 +59,7 @@ func (s *TradingDayTickerService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/ticker/tradingDay",
+		weight:   symbolsWeight(s.symbol, s.symbols),
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
@@ -64,6 +64,7 @@ func (s *UiKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/uiKlines",
+		weight:   2,
 	}
 	r.setParam("symbol", s.symbol)
 	r.setParam("interval", s.interval)
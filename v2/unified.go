@@ -0,0 +1,204 @@
+package binance
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// UnifiedConfig define the configuration shared by the product clients of a Unified client
+type UnifiedConfig struct {
+	APIKey    string
+	SecretKey string
+	KeyType   string
+	// Signer signs the requests of all the products instead of KeyType and SecretKey when set
+	Signer common.Signer
+
+	// Environments of the products, the default environments of the packages are used when nil
+	Spot     *Environment
+	Futures  *futures.Environment
+	Delivery *delivery.Environment
+	Options  *options.Environment
+
+	// HTTPClient is the transport shared by all the products,
+	// by default it is built from the proxy and the timeout of the spot environment
+	HTTPClient  *http.Client
+	RetryPolicy common.RetryPolicy
+	Middlewares []common.Middleware
+	// TimeSyncInterval starts a background time sync shared by all the products when positive,
+	// see Unified.TimeSync
+	TimeSyncInterval time.Duration
+
+	UserAgent string
+	Debug     bool
+	Logger    *log.Logger
+	// StructuredLogger receives the logs of the requests of all the products, see Client.StructuredLogger
+	StructuredLogger common.Logger
+}
+
+// Unified gives access to the spot, futures, delivery and options clients built from a single
+// configuration. They share one HTTP transport, one signer and one time synchroniser, every product keeps
+// the RateLimiter of its own limits, services are still created by the form unified.Spot.NewXXXService(), unified.Futures.NewXXXService()...
+type Unified struct {
+	Spot     *Client
+	Futures  *futures.Client
+	Delivery *delivery.Client
+	Options  *options.Client
+
+	// TimeSync keeps the time offsets of all the products in sync with the spot server time,
+	// it resyncs whenever a request of any product fails with -1021
+	TimeSync   *common.TimeSync
+	Signer     common.Signer
+	HTTPClient *http.Client
+}
+
+// NewUnified init the product clients from cfg
+func NewUnified(cfg UnifiedConfig) (*Unified, error) {
+	signer := cfg.Signer
+	if signer == nil {
+		var err error
+		signer, err = common.NewSigner(cfg.KeyType, cfg.SecretKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Spot == nil {
+		cfg.Spot = DefaultEnvironment()
+	}
+	if cfg.Futures == nil {
+		cfg.Futures = futures.DefaultEnvironment()
+	}
+	if cfg.Delivery == nil {
+		cfg.Delivery = delivery.DefaultEnvironment()
+	}
+	if cfg.Options == nil {
+		cfg.Options = options.DefaultEnvironment()
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = common.NewHTTPClient(cfg.Spot.ProxyURL, cfg.Spot.HTTPTimeout)
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = "Binance/golang"
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = log.New(os.Stderr, "Binance-golang ", log.LstdFlags)
+	}
+	keyType := cfg.KeyType
+	if keyType == "" {
+		keyType = common.KeyTypeHmac
+	}
+
+	u := &Unified{
+		Spot: &Client{
+			APIKey:           cfg.APIKey,
+			SecretKey:        cfg.SecretKey,
+			KeyType:          keyType,
+			BaseURL:          cfg.Spot.APIURL,
+			UserAgent:        userAgent,
+			HTTPClient:       httpClient,
+			Debug:            cfg.Debug,
+			Logger:           logger,
+			Signer:           signer,
+			RateLimiter:      common.NewRateLimiter(),
+			RetryPolicy:      cfg.RetryPolicy,
+			Environment:      cfg.Spot,
+			Middlewares:      append([]common.Middleware(nil), cfg.Middlewares...),
+			StructuredLogger: cfg.StructuredLogger,
+		},
+		Futures: &futures.Client{
+			APIKey:           cfg.APIKey,
+			SecretKey:        cfg.SecretKey,
+			KeyType:          keyType,
+			BaseURL:          cfg.Futures.APIURL,
+			UserAgent:        userAgent,
+			HTTPClient:       httpClient,
+			Debug:            cfg.Debug,
+			Logger:           logger,
+			Signer:           signer,
+			RateLimiter:      common.NewRateLimiter(),
+			RetryPolicy:      cfg.RetryPolicy,
+			Environment:      cfg.Futures,
+			Middlewares:      append([]common.Middleware(nil), cfg.Middlewares...),
+			StructuredLogger: cfg.StructuredLogger,
+		},
+		Delivery: &delivery.Client{
+			APIKey:           cfg.APIKey,
+			SecretKey:        cfg.SecretKey,
+			KeyType:          keyType,
+			BaseURL:          cfg.Delivery.APIURL,
+			UserAgent:        userAgent,
+			HTTPClient:       httpClient,
+			Debug:            cfg.Debug,
+			Logger:           logger,
+			Signer:           signer,
+			RateLimiter:      common.NewRateLimiter(),
+			RetryPolicy:      cfg.RetryPolicy,
+			Environment:      cfg.Delivery,
+			Middlewares:      append([]common.Middleware(nil), cfg.Middlewares...),
+			StructuredLogger: cfg.StructuredLogger,
+		},
+		Options: &options.Client{
+			APIKey:           cfg.APIKey,
+			SecretKey:        cfg.SecretKey,
+			KeyType:          keyType,
+			BaseURL:          cfg.Options.APIURL,
+			UserAgent:        userAgent,
+			HTTPClient:       httpClient,
+			Debug:            cfg.Debug,
+			Logger:           logger,
+			Signer:           signer,
+			RateLimiter:      common.NewRateLimiter(),
+			RetryPolicy:      cfg.RetryPolicy,
+			Environment:      cfg.Options,
+			Middlewares:      append([]common.Middleware(nil), cfg.Middlewares...),
+			StructuredLogger: cfg.StructuredLogger,
+		},
+		Signer:     signer,
+		HTTPClient: httpClient,
+	}
+	u.TimeSync = common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return u.Spot.NewServerTimeService().Do(ctx)
+	}, cfg.TimeSyncInterval,
+		&u.Spot.TimeOffset, &u.Futures.TimeOffset, &u.Delivery.TimeOffset, &u.Options.TimeOffset)
+	u.Spot.TimeSync = u.TimeSync
+	u.Futures.TimeSync = u.TimeSync
+	u.Delivery.TimeSync = u.TimeSync
+	u.Options.TimeSync = u.TimeSync
+	if cfg.TimeSyncInterval > 0 {
+		u.TimeSync.Start()
+	}
+	return u, nil
+}
+
+// Use append middlewares to the chains of all the products
+func (u *Unified) Use(middlewares ...common.Middleware) *Unified {
+	u.Spot.Use(middlewares...)
+	u.Futures.Use(middlewares...)
+	u.Delivery.Use(middlewares...)
+	u.Options.Use(middlewares...)
+	return u
+}
+
+// SetDebug switch the debug logs of all the products
+func (u *Unified) SetDebug(debug bool) *Unified {
+	u.Spot.Debug = debug
+	u.Futures.Debug = debug
+	u.Delivery.Debug = debug
+	u.Options.Debug = debug
+	return u
+}
+
+// Close stop the background time sync
+func (u *Unified) Close() {
+	u.TimeSync.Stop()
+}
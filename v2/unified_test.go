@@ -0,0 +1,78 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/options"
+)
+
+func TestUnified(t *testing.T) {
+	var mu sync.Mutex
+	var signatures []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		signatures = append(signatures, r.URL.Query().Get(signatureKey))
+		mu.Unlock()
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "42")
+		switch r.URL.Path {
+		case "/api/v3/time":
+			fmt.Fprintf(w, `{"serverTime":%d}`, currentTimestamp()-1000)
+		case "/fapi/v2/balance":
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+
+	var endpoints []string
+	u, err := NewUnified(UnifiedConfig{
+		APIKey:   "dummyAPIKey",
+		Signer:   common.SignerFunc(func(payload string) (string, error) { return "shared", nil }),
+		Spot:     &Environment{APIURL: server.URL},
+		Futures:  &futures.Environment{APIURL: server.URL},
+		Delivery: &delivery.Environment{APIURL: server.URL},
+		Options:  &options.Environment{APIURL: server.URL},
+		Middlewares: []common.Middleware{func(next common.CallHandler) common.CallHandler {
+			return func(ctx context.Context, call *common.APICall) (*common.APIResponse, error) {
+				endpoints = append(endpoints, call.Endpoint)
+				return next(ctx, call)
+			}
+		}},
+	})
+	require.NoError(t, err)
+	defer u.Close()
+
+	assert.True(t, u.Spot.HTTPClient == u.Futures.HTTPClient)
+	assert.True(t, u.Delivery.HTTPClient == u.Options.HTTPClient)
+	// the products have distinct limits
+	assert.True(t, u.Spot.RateLimiter != u.Futures.RateLimiter)
+	assert.True(t, u.Delivery.RateLimiter != u.Options.RateLimiter)
+
+	_, err = u.TimeSync.Sync(context.Background())
+	require.NoError(t, err)
+	for _, offset := range []int64{u.Spot.TimeOffset, u.Futures.TimeOffset, u.Delivery.TimeOffset, u.Options.TimeOffset} {
+		assert.InDelta(t, 1000, offset, 500)
+	}
+
+	_, err = u.Futures.NewGetBalanceService().Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "shared", signatures[len(signatures)-1])
+	assert.Equal(t, int64(42), u.Futures.RateLimiter.UsedWeight("1M"))
+	assert.Zero(t, u.Delivery.RateLimiter.UsedWeight("1M"))
+	assert.Equal(t, "/fapi/v2/balance", endpoints[len(endpoints)-1])
+
+	_, err = NewUnified(UnifiedConfig{KeyType: common.KeyTypeRsa, SecretKey: "invalid"})
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@ func (s *StartUserStreamService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/api/v3/userDataStream",
+		weight:   2,
 		secType:  secTypeAPIKey,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -46,6 +47,7 @@ func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOpti
 	r := &request{
 		method:   http.MethodPut,
 		endpoint: "/api/v3/userDataStream",
+		weight:   2,
 		secType:  secTypeAPIKey,
 	}
 	r.setFormParam("listenKey", s.listenKey)
@@ -70,6 +72,7 @@ func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption)
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/api/v3/userDataStream",
+		weight:   2,
 		secType:  secTypeAPIKey,
 	}
 	r.setFormParam("listenKey", s.listenKey)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateWithdrawService submits a withdraw request.
@@ -211,6 +214,28 @@ func (s *ListWithdrawsService) Do(ctx context.Context, opts ...RequestOption) (r
 	return res, nil
 }
 
+// Iterator iterate over the withdrawals between StartTime and EndTime, the last 90 days by default.
+// The withdrawals are fetched by offset in windows of 90 days.
+func (s *ListWithdrawsService) Iterator(opts ...RequestOption) *common.Iterator[*Withdraw] {
+	limit := maxHistoryLimit
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	return common.NewIterator(common.OffsetPager[*Withdraw]{
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Window:    90 * 24 * time.Hour,
+		Limit:     limit,
+		Now:       s.c.serverTimestamp,
+		Fetch: func(ctx context.Context, start, end int64, offset int) ([]*Withdraw, error) {
+			p := *s
+			p.startTime, p.endTime, p.offset, p.limit = &start, &end, &offset, &limit
+			return p.Do(ctx, opts...)
+		},
+		Key: func(w *Withdraw) string { return w.ID },
+	}.Pages())
+}
+
 // Withdraw represents a single withdraw entry.
 type Withdraw struct {
 	Address         string `json:"address"`